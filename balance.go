@@ -0,0 +1,129 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// balance ledger
+type BalanceLedgerFilter struct {
+	PageFilter
+}
+
+// Balance is the available balance of the integration in a single currency.
+type Balance struct {
+	Currency string `json:"currency"`
+	Balance  Money  `json:"balance"`
+}
+
+// LedgerEntry is one movement on the integration balance.
+type LedgerEntry struct {
+	ID               int       `json:"id"`
+	Integration      int       `json:"integration"`
+	Domain           string    `json:"domain"`
+	Balance          Money     `json:"balance"`    // balance after the movement
+	Difference       Money     `json:"difference"` // negative for debits
+	Currency         string    `json:"currency"`
+	Reason           string    `json:"reason"`
+	ModelResponsible string    `json:"model_responsible"` // e.g. Transfer or Charge
+	ModelRow         int       `json:"model_row"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+type BalanceResponse struct {
+	Status  bool      `json:"status"`
+	Message string    `json:"message"`
+	Data    []Balance `json:"data"`
+}
+
+type BalanceLedgerResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []LedgerEntry   `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+// UnmarshalJSON fills in the currency of the balance.
+func (b *Balance) UnmarshalJSON(data []byte) error {
+	type alias Balance
+	if err := json.Unmarshal(data, (*alias)(b)); err != nil {
+		return err
+	}
+	b.Balance.Currency = Currency(b.Currency)
+	return nil
+}
+
+// UnmarshalJSON fills in the currency of the amounts from the entry's currency.
+func (e *LedgerEntry) UnmarshalJSON(data []byte) error {
+	type alias LedgerEntry
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	currency := Currency(e.Currency)
+	e.Balance.Currency = currency
+	e.Difference.Currency = currency
+	return nil
+}
+
+// Find returns the balance in currency, or false if the integration has none.
+func (r *BalanceResponse) Find(currency Currency) (Money, bool) {
+	for _, balance := range r.Data {
+		if Currency(balance.Currency) == currency {
+			return balance.Balance, true
+		}
+	}
+	return Money{Currency: currency}, false
+}
+
+// CheckBalance returns the available balance of the integration in each currency.
+func (p *Paystack) CheckBalance() (*BalanceResponse, error) {
+	return p.CheckBalanceContext(context.Background())
+}
+
+// CheckBalanceContext is like CheckBalance but aborts the call when ctx is cancelled.
+func (p *Paystack) CheckBalanceContext(ctx context.Context) (*BalanceResponse, error) {
+	return do[BalanceResponse](ctx, p, "balance.check", http.MethodGet, "/balance", nil, nil)
+}
+
+// HasSufficientBalance reports whether the balance in the currency of amount
+// covers it, so that transfers can be checked before they are initiated.
+func (p *Paystack) HasSufficientBalance(amount Money) (bool, error) {
+	return p.HasSufficientBalanceContext(context.Background(), amount)
+}
+
+// HasSufficientBalanceContext is like HasSufficientBalance but aborts the call when ctx is cancelled.
+func (p *Paystack) HasSufficientBalanceContext(ctx context.Context, amount Money) (bool, error) {
+	if amount.Currency == "" {
+		return false, errors.New("amount currency is required")
+	}
+	response, err := p.CheckBalanceContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	balance, _ := response.Find(amount.Currency)
+	return balance.Amount >= amount.Amount, nil
+}
+
+// BalanceLedger lists the movements on the integration balance.
+func (p *Paystack) BalanceLedger(filter BalanceLedgerFilter) (*BalanceLedgerResponse, error) {
+	return p.BalanceLedgerContext(context.Background(), filter)
+}
+
+// BalanceLedgerContext is like BalanceLedger but aborts the call when ctx is cancelled.
+func (p *Paystack) BalanceLedgerContext(ctx context.Context, filter BalanceLedgerFilter) (*BalanceLedgerResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	//encode non-empty values as params
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[BalanceLedgerResponse](ctx, p, "balance.ledger", http.MethodGet, "/balance/ledger", query, nil)
+}
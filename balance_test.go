@@ -0,0 +1,66 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheckBalance(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/balance",
+		`{"status":true,"message":"Balances retrieved","data":[{"currency":"NGN","balance":1700000},{"currency":"USD","balance":0}]}`,
+		nil)
+
+	resp, err := p.CheckBalance()
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if balance, ok := resp.Find(NGN); !ok || balance != NewMoney(1700000, NGN) {
+		t.Errorf("Unexpected NGN balance: %v", balance)
+	}
+	if _, ok := resp.Find(GHS); ok {
+		t.Error("Expected no GHS balance")
+	}
+}
+
+func TestHasSufficientBalance(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/balance",
+		`{"status":true,"message":"Balances retrieved","data":[{"currency":"NGN","balance":1700000}]}`,
+		nil)
+
+	tests := []struct {
+		amount Money
+		want   bool
+	}{
+		{NewMoney(1700000, NGN), true},
+		{NewMoney(1700001, NGN), false},
+		{NewMoney(100, GHS), false},
+	}
+	for _, test := range tests {
+		ok, err := p.HasSufficientBalance(test.amount)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if ok != test.want {
+			t.Errorf("HasSufficientBalance(%v) = %v, want %v", test.amount, ok, test.want)
+		}
+	}
+}
+
+func TestBalanceLedger(t *testing.T) {
+	p, last := stubClient(t, "GET", "/balance/ledger",
+		`{"status":true,"message":"Balance ledger retrieved","data":[{"integration":463433,"domain":"test","balance":2078224,"currency":"NGN","difference":-50000,"reason":"Transfer to recipient","model_responsible":"Transfer","model_row":56607838,"id":2233640,"createdAt":"2024-09-12T10:30:18.000Z"}],"meta":{"total":1,"skipped":0,"perPage":50,"page":1,"pageCount":1}}`,
+		nil)
+
+	from := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := p.BalanceLedger(BalanceLedgerFilter{PageFilter{From: &from}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if last.URL.Query().Get("from") != "2024-09-01T00:00:00Z" {
+		t.Errorf("Unexpected query: %s", last.URL.RawQuery)
+	}
+	entry := resp.Data[0]
+	if entry.Difference != NewMoney(-50000, NGN) || entry.ModelResponsible != "Transfer" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
@@ -0,0 +1,81 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/berryboylb/go_paystack_wrapper/requests"
+)
+
+// do sends a request to path and decodes a successful response into a new T.
+// Every endpoint goes through here so status checks, error typing and
+// middleware live in one place. endpoint names the call for middleware.
+func do[T any](ctx context.Context, p *Paystack, endpoint, method, path string, query url.Values, payload interface{}) (*T, error) {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	call := &Call{
+		Endpoint: endpoint,
+		Method:   method,
+		Path:     path,
+		Header:   make(http.Header),
+		Body:     payload,
+		Response: new(T),
+	}
+	if err := p.chain(DoerFunc(p.send)).Do(ctx, call); err != nil {
+		return nil, err
+	}
+
+	response, ok := call.Response.(*T)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T for %s", call.Response, endpoint)
+	}
+	return response, nil
+}
+
+// send is the innermost Doer: it performs the HTTP call and decodes into call.Response.
+func (p *Paystack) send(ctx context.Context, call *Call) error {
+	if len(call.Header) > 0 {
+		ctx = requests.ContextWithHeaders(ctx, call.Header)
+	}
+
+	//initialize new request
+	resp, err := p.newRequest().Do(ctx, call.Method, call.Path, call.Body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	call.StatusCode = resp.StatusCode
+
+	// read the response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.New("Error reading response body: " + err.Error())
+	}
+
+	//check for response
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return newAPIError(resp, body)
+	}
+
+	//check if response.status is not okay
+	var envelope Error
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return errors.New("Error decoding JSON: " + err.Error())
+	}
+	if !envelope.Status {
+		return newAPIError(resp, body)
+	}
+
+	// convert to JSON object
+	if err := json.Unmarshal(body, call.Response); err != nil {
+		return errors.New("Error decoding JSON: " + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRejectsFalseStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+	}))
+	defer server.Close()
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+	resp, err := p.Verify("missing")
+	if resp != nil {
+		t.Errorf("Expected no response, but got: %+v", resp)
+	}
+	apiErr, ok := asAPIError(err)
+	if !ok || apiErr.Message != "Transaction reference not found" {
+		t.Errorf("Expected an *APIError carrying Paystack's message, but got: %v", err)
+	}
+	if want := "paystack request failed (200): Transaction reference not found"; err.Error() != want {
+		t.Errorf("Expected %q, but got: %q", want, err.Error())
+	}
+}
@@ -0,0 +1,120 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// charge a reusable authorization
+type ChargeAuthorizationInput struct {
+	Email             string                 `json:"email" validate:"required,email"`
+	Amount            Money                  `json:"amount" validate:"required,gt=0"`
+	AuthorizationCode string                 `json:"authorization_code" validate:"required"`
+	Reference         string                 `json:"reference,omitempty"`
+	Currency          string                 `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+	Channels          []Channel              `json:"channels,omitempty" validate:"omitempty,dive,oneof=card bank"`
+	Subaccount        string                 `json:"subaccount,omitempty"`
+	SplitCode         string                 `json:"split_code,omitempty"`
+	Bearer            Bearer                 `json:"bearer,omitempty" validate:"omitempty,oneof=account subaccount"`
+	Queue             bool                   `json:"queue,omitempty"` // process in the background, for bulk charges
+}
+
+// check that an authorization can be charged an amount
+type CheckAuthorizationInput struct {
+	Email             string `json:"email" validate:"required,email"`
+	Amount            Money  `json:"amount" validate:"required,gt=0"`
+	AuthorizationCode string `json:"authorization_code" validate:"required"`
+	Currency          string `json:"currency,omitempty" validate:"omitempty,len=3"`
+}
+
+// charge up to an amount, accepting anything above AtLeast
+type PartialDebitInput struct {
+	Email             string `json:"email" validate:"required,email"`
+	Amount            Money  `json:"amount" validate:"required,gt=0"`
+	AuthorizationCode string `json:"authorization_code" validate:"required"`
+	Currency          string `json:"currency" validate:"omitempty,len=3"`
+	Reference         string `json:"reference,omitempty"`
+	AtLeast           *Money `json:"at_least,omitempty"`
+}
+
+type CheckAuthorizationResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Amount   Money  `json:"amount"`
+		Currency string `json:"currency"`
+	} `json:"data"`
+}
+
+// ChargeAuthorization charges a reusable authorization, e.g. for recurring billing.
+func (p *Paystack) ChargeAuthorization(payload ChargeAuthorizationInput) (*GetResponseData, error) {
+	return p.ChargeAuthorizationContext(context.Background(), payload)
+}
+
+// ChargeAuthorizationContext is like ChargeAuthorization but aborts the call when ctx is cancelled.
+func (p *Paystack) ChargeAuthorizationContext(ctx context.Context, payload ChargeAuthorizationInput) (*GetResponseData, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.Currency, err = resolveCurrency(payload.Currency, payload.Amount); err != nil {
+		return nil, err
+	}
+	return do[GetResponseData](ctx, p, "transaction.charge_authorization", http.MethodPost, "/transaction/charge_authorization", nil, payload)
+}
+
+// CheckAuthorization checks whether an authorization can be charged the given amount.
+func (p *Paystack) CheckAuthorization(payload CheckAuthorizationInput) (*CheckAuthorizationResponse, error) {
+	return p.CheckAuthorizationContext(context.Background(), payload)
+}
+
+// CheckAuthorizationContext is like CheckAuthorization but aborts the call when ctx is cancelled.
+func (p *Paystack) CheckAuthorizationContext(ctx context.Context, payload CheckAuthorizationInput) (*CheckAuthorizationResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.Currency, err = resolveCurrency(payload.Currency, payload.Amount); err != nil {
+		return nil, err
+	}
+	response, err := do[CheckAuthorizationResponse](ctx, p, "transaction.check_authorization", http.MethodPost, "/transaction/check_authorization", nil, payload)
+	if err != nil {
+		return nil, err
+	}
+	response.Data.Amount.Currency = Currency(response.Data.Currency)
+	return response, nil
+}
+
+// PartialDebit charges an authorization for as much of Amount as is available,
+// but no less than AtLeast when it is set.
+func (p *Paystack) PartialDebit(payload PartialDebitInput) (*GetResponseData, error) {
+	return p.PartialDebitContext(context.Background(), payload)
+}
+
+// PartialDebitContext is like PartialDebit but aborts the call when ctx is cancelled.
+func (p *Paystack) PartialDebitContext(ctx context.Context, payload PartialDebitInput) (*GetResponseData, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.AtLeast != nil && (payload.AtLeast.Amount <= 0 || payload.AtLeast.Amount > payload.Amount.Amount) {
+		return nil, errors.New("at_least must be greater than zero and not more than amount")
+	}
+	if payload.Currency, err = resolveCurrency(payload.Currency, payload.Amount); err != nil {
+		return nil, err
+	}
+	if payload.AtLeast != nil {
+		if payload.Currency, err = resolveCurrency(payload.Currency, *payload.AtLeast); err != nil {
+			return nil, err
+		}
+	}
+	if payload.Currency == "" {
+		return nil, errors.New("currency is required for partial debits")
+	}
+	return do[GetResponseData](ctx, p, "transaction.partial_debit", http.MethodPost, "/transaction/partial_debit", nil, payload)
+}
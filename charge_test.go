@@ -0,0 +1,64 @@
+package paystack
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestChargeAuthorization(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/transaction/charge_authorization",
+		`{"status":true,"message":"Charge attempted","data":{"amount":35247,"currency":"NGN","status":"success","reference":"0m7frfnr47ezyxl","authorization":{"authorization_code":"AUTH_uh8bcl3zbn","reusable":true},"customer":{"email":"mail@mail.com"}}}`,
+		&received)
+
+	resp, err := p.ChargeAuthorization(ChargeAuthorizationInput{
+		Email:             "mail@mail.com",
+		Amount:            NewMoney(35247, NGN),
+		AuthorizationCode: "AUTH_uh8bcl3zbn",
+		Queue:             true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Status != "success" || resp.Data.Amount != NewMoney(35247, NGN) {
+		t.Errorf("Unexpected transaction: %+v", resp.Data)
+	}
+	if received["currency"] != "NGN" || received["queue"] != true || received["amount"] != float64(35247) {
+		t.Errorf("Unexpected payload: %v", received)
+	}
+}
+
+func TestPartialDebitValidation(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	atLeast := NewMoney(20000, NGN)
+	_, err := p.PartialDebit(PartialDebitInput{
+		Email:             "mail@mail.com",
+		Amount:            NewMoney(10000, NGN),
+		AuthorizationCode: "AUTH_72btv547",
+		AtLeast:           &atLeast,
+	})
+	if err == nil {
+		t.Errorf("Expected at_least above amount to be rejected")
+	}
+
+	_, err = p.PartialDebit(PartialDebitInput{
+		Email:             "mail@mail.com",
+		Amount:            NewMoney(10000, NGN),
+		AuthorizationCode: "AUTH_72btv547",
+		Currency:          "GHS",
+	})
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch, but got: %v", err)
+	}
+
+	atLeast = NewMoney(5000, GHS)
+	_, err = p.PartialDebit(PartialDebitInput{
+		Email:             "mail@mail.com",
+		Amount:            NewMoney(10000, NGN),
+		AuthorizationCode: "AUTH_72btv547",
+		AtLeast:           &atLeast,
+	})
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected at_least in another currency to be rejected, but got: %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// RiskAction is the fraud rule applied to a customer.
+type RiskAction string
+
+const (
+	RiskDefault RiskAction = "default"
+	RiskAllow   RiskAction = "allow" // whitelist
+	RiskDeny    RiskAction = "deny"  // blacklist
+)
+
+// create customer
+type CustomerInput struct {
+	Email     string                 `json:"email" validate:"required,email"`
+	FirstName string                 `json:"first_name,omitempty"`
+	LastName  string                 `json:"last_name,omitempty"`
+	Phone     string                 `json:"phone,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// update customer, empty fields are left unchanged
+type UpdateCustomerInput struct {
+	FirstName string                 `json:"first_name,omitempty"`
+	LastName  string                 `json:"last_name,omitempty"`
+	Phone     string                 `json:"phone,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// list customers
+type ListCustomers struct {
+	PageFilter
+}
+
+// identity verification of a customer
+type ValidateCustomerInput struct {
+	Country       string `json:"country" validate:"required,len=2"`
+	Type          string `json:"type" validate:"required,oneof=bank_account"`
+	AccountNumber string `json:"account_number" validate:"required"`
+	BVN           string `json:"bvn" validate:"required,len=11,numeric"`
+	BankCode      string `json:"bank_code" validate:"required"`
+	FirstName     string `json:"first_name" validate:"required"`
+	LastName      string `json:"last_name" validate:"required"`
+	MiddleName    string `json:"middle_name,omitempty"`
+}
+
+type Customer struct {
+	CustomerData
+	Integration     int64               `json:"integration"`
+	Domain          string              `json:"domain"`
+	Identified      bool                `json:"identified"`
+	Identifications interface{}         `json:"identifications"`
+	Authorizations  []AuthorizationData `json:"authorizations"`
+	CreatedAt       string              `json:"createdAt"`
+	UpdatedAt       string              `json:"updatedAt"`
+}
+
+type CustomerResponse struct {
+	Status  bool     `json:"status"`
+	Message string   `json:"message"`
+	Data    Customer `json:"data"`
+}
+
+type CustomerListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Customer      `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+func (p *Paystack) CreateCustomer(payload CustomerInput) (*CustomerResponse, error) {
+	return p.CreateCustomerContext(context.Background(), payload)
+}
+
+// CreateCustomerContext is like CreateCustomer but aborts the call when ctx is cancelled.
+func (p *Paystack) CreateCustomerContext(ctx context.Context, payload CustomerInput) (*CustomerResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[CustomerResponse](ctx, p, "customer.create", http.MethodPost, "/customer", nil, payload)
+}
+
+func (p *Paystack) ListCustomers(filter ListCustomers) (*CustomerListResponse, error) {
+	return p.ListCustomersContext(context.Background(), filter)
+}
+
+// ListCustomersContext is like ListCustomers but aborts the call when ctx is cancelled.
+func (p *Paystack) ListCustomersContext(ctx context.Context, filter ListCustomers) (*CustomerListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[CustomerListResponse](ctx, p, "customer.list", http.MethodGet, "/customer", query, nil)
+}
+
+// FetchCustomer returns a customer by email address or customer code.
+func (p *Paystack) FetchCustomer(emailOrCode string) (*CustomerResponse, error) {
+	return p.FetchCustomerContext(context.Background(), emailOrCode)
+}
+
+// FetchCustomerContext is like FetchCustomer but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchCustomerContext(ctx context.Context, emailOrCode string) (*CustomerResponse, error) {
+	if emailOrCode == "" {
+		return nil, errors.New("email or customer code is required")
+	}
+	return do[CustomerResponse](ctx, p, "customer.fetch", http.MethodGet, "/customer/"+url.PathEscape(emailOrCode), nil, nil)
+}
+
+func (p *Paystack) UpdateCustomer(code string, payload UpdateCustomerInput) (*CustomerResponse, error) {
+	return p.UpdateCustomerContext(context.Background(), code, payload)
+}
+
+// UpdateCustomerContext is like UpdateCustomer but aborts the call when ctx is cancelled.
+func (p *Paystack) UpdateCustomerContext(ctx context.Context, code string, payload UpdateCustomerInput) (*CustomerResponse, error) {
+	if code == "" {
+		return nil, errors.New("customer code is required")
+	}
+	return do[CustomerResponse](ctx, p, "customer.update", http.MethodPut, "/customer/"+url.PathEscape(code), nil, payload)
+}
+
+// ValidateCustomer starts identity verification of a customer. The outcome is
+// delivered through the customeridentification.* webhooks.
+func (p *Paystack) ValidateCustomer(code string, payload ValidateCustomerInput) (*MessageResponse, error) {
+	return p.ValidateCustomerContext(context.Background(), code, payload)
+}
+
+// ValidateCustomerContext is like ValidateCustomer but aborts the call when ctx is cancelled.
+func (p *Paystack) ValidateCustomerContext(ctx context.Context, code string, payload ValidateCustomerInput) (*MessageResponse, error) {
+	if code == "" {
+		return nil, errors.New("customer code is required")
+	}
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[MessageResponse](ctx, p, "customer.validate", http.MethodPost, "/customer/"+url.PathEscape(code)+"/identification", nil, payload)
+}
+
+// SetRiskAction whitelists (RiskAllow) or blacklists (RiskDeny) a customer by email or code.
+func (p *Paystack) SetRiskAction(emailOrCode string, action RiskAction) (*CustomerResponse, error) {
+	return p.SetRiskActionContext(context.Background(), emailOrCode, action)
+}
+
+// SetRiskActionContext is like SetRiskAction but aborts the call when ctx is cancelled.
+func (p *Paystack) SetRiskActionContext(ctx context.Context, emailOrCode string, action RiskAction) (*CustomerResponse, error) {
+	if emailOrCode == "" {
+		return nil, errors.New("email or customer code is required")
+	}
+	switch action {
+	case RiskDefault, RiskAllow, RiskDeny:
+	default:
+		return nil, errors.New("invalid risk action " + string(action))
+	}
+	requestBody := map[string]interface{}{
+		"customer":    emailOrCode,
+		"risk_action": action,
+	}
+	return do[CustomerResponse](ctx, p, "customer.set_risk_action", http.MethodPost, "/customer/set_risk_action", nil, requestBody)
+}
+
+// DeactivateAuthorization stops a saved card authorization from being charged again.
+func (p *Paystack) DeactivateAuthorization(authorizationCode string) (*MessageResponse, error) {
+	return p.DeactivateAuthorizationContext(context.Background(), authorizationCode)
+}
+
+// DeactivateAuthorizationContext is like DeactivateAuthorization but aborts the call when ctx is cancelled.
+func (p *Paystack) DeactivateAuthorizationContext(ctx context.Context, authorizationCode string) (*MessageResponse, error) {
+	if authorizationCode == "" {
+		return nil, errors.New("authorization code is required")
+	}
+	requestBody := map[string]interface{}{
+		"authorization_code": authorizationCode,
+	}
+	return do[MessageResponse](ctx, p, "customer.deactivate_authorization", http.MethodPost, "/customer/deactivate_authorization", nil, requestBody)
+}
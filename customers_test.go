@@ -0,0 +1,73 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCreateCustomer(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/customer",
+		`{"status":true,"message":"Customer created","data":{"email":"customer@email.com","customer_code":"CUS_xnxdt6s1zg1f4nx","id":1173,"integration":100032,"identified":false}}`,
+		&received)
+
+	resp, err := p.CreateCustomer(CustomerInput{Email: "customer@email.com", FirstName: "Zero"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.CustomerCode != "CUS_xnxdt6s1zg1f4nx" || resp.Data.Integration != 100032 {
+		t.Errorf("Unexpected customer: %+v", resp.Data)
+	}
+	if received["first_name"] != "Zero" || received["last_name"] != nil {
+		t.Errorf("Unexpected payload: %v", received)
+	}
+
+	if _, err := p.CreateCustomer(CustomerInput{Email: "nope"}); err == nil {
+		t.Errorf("Expected invalid email to be rejected")
+	}
+}
+
+func TestListCustomers(t *testing.T) {
+	p, last := stubClient(t, "GET", "/customer",
+		`{"status":true,"message":"Customers retrieved","data":[{"email":"a@b.co","customer_code":"CUS_1"}],"meta":{"total":1,"perPage":"50","page":1,"pageCount":1}}`,
+		nil)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := p.ListCustomers(ListCustomers{PageFilter{PerPage: 50, Page: 1, From: &from}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if got := last.URL.Query().Get("from"); got != "2024-01-01T00:00:00Z" {
+		t.Errorf("Expected from to be encoded as RFC3339, but got: %q", got)
+	}
+	if len(resp.Data) != 1 || resp.Meta.PerPage != "50" {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+}
+
+func TestSetRiskAction(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/customer/set_risk_action",
+		`{"status":true,"message":"Customer updated","data":{"customer_code":"CUS_1","risk_action":"deny"}}`,
+		&received)
+
+	resp, err := p.SetRiskAction("CUS_1", RiskDeny)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.RiskAction != "deny" || received["risk_action"] != "deny" {
+		t.Errorf("Unexpected response %+v for payload %v", resp.Data, received)
+	}
+	if _, err := p.SetRiskAction("CUS_1", "block"); err == nil {
+		t.Errorf("Expected unknown risk action to be rejected")
+	}
+}
+
+func TestUpdateCustomerUsesPut(t *testing.T) {
+	p, _ := stubClient(t, "PUT", "/customer/CUS_1",
+		`{"status":true,"message":"Customer updated","data":{"customer_code":"CUS_1"}}`,
+		nil)
+	if _, err := p.UpdateCustomer("CUS_1", UpdateCustomerInput{Phone: "+2348000000000"}); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+}
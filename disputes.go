@@ -0,0 +1,333 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+type DisputeStatus string
+
+const (
+	DisputeAwaitingMerchantFeedback DisputeStatus = "awaiting-merchant-feedback"
+	DisputeAwaitingBankFeedback     DisputeStatus = "awaiting-bank-feedback"
+	DisputePending                  DisputeStatus = "pending"
+	DisputeResolved                 DisputeStatus = "resolved"
+	DisputeArchived                 DisputeStatus = "archived"
+)
+
+type DisputeResolution string
+
+const (
+	ResolutionMerchantAccepted DisputeResolution = "merchant-accepted"
+	ResolutionDeclined         DisputeResolution = "declined"
+)
+
+// list and export disputes
+type ListDisputes struct {
+	PageFilter
+	Transaction string        `json:"transaction" schema:"transaction"` // transaction ID
+	Status      DisputeStatus `json:"status" schema:"status"`
+}
+
+// update dispute
+type UpdateDisputeInput struct {
+	RefundAmount     Money  `json:"refund_amount" validate:"gte=0"`
+	UploadedFilename string `json:"uploaded_filename,omitempty"`
+}
+
+// add evidence to a dispute
+type EvidenceInput struct {
+	CustomerEmail   string `json:"customer_email" validate:"required,email"`
+	CustomerName    string `json:"customer_name" validate:"required"`
+	CustomerPhone   string `json:"customer_phone" validate:"required"`
+	ServiceDetails  string `json:"service_details" validate:"required"`
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	DeliveryDate    string `json:"delivery_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+// resolve dispute
+type ResolveDisputeInput struct {
+	Resolution       DisputeResolution `json:"resolution" validate:"required,oneof=merchant-accepted declined"`
+	Message          string            `json:"message" validate:"required"`
+	RefundAmount     *Money            `json:"refund_amount,omitempty" validate:"omitempty,gte=0"` // amount to refund when accepting
+	UploadedFilename string            `json:"uploaded_filename" validate:"required"`
+	Evidence         int64             `json:"evidence,omitempty"` // evidence ID, required when declining
+}
+
+type DisputeHistory struct {
+	Status    DisputeStatus `json:"status"`
+	By        string        `json:"by"`
+	CreatedAt string        `json:"createdAt"`
+}
+
+type DisputeMessage struct {
+	Sender    string `json:"sender"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type Evidence struct {
+	ID              int64   `json:"id"`
+	Dispute         int64   `json:"dispute"`
+	CustomerEmail   string  `json:"customer_email"`
+	CustomerName    string  `json:"customer_name"`
+	CustomerPhone   string  `json:"customer_phone"`
+	ServiceDetails  string  `json:"service_details"`
+	DeliveryAddress *string `json:"delivery_address"` // Use a pointer to allow for null values
+	DeliveryDate    *string `json:"delivery_date"`    // Use a pointer to allow for null values
+	CreatedAt       string  `json:"createdAt"`
+	UpdatedAt       string  `json:"updatedAt"`
+}
+
+type Dispute struct {
+	ID                   int64              `json:"id"`
+	Domain               string             `json:"domain"`
+	Status               DisputeStatus      `json:"status"`
+	Resolution           *DisputeResolution `json:"resolution"`    // Use a pointer to allow for null values
+	RefundAmount         *Money             `json:"refund_amount"` // Use a pointer to allow for null values
+	Currency             *string            `json:"currency"`      // Use a pointer to allow for null values
+	Category             *string            `json:"category"`      // Use a pointer to allow for null values
+	Transaction          *TransactionData   `json:"transaction"`
+	TransactionReference *string            `json:"transaction_reference"` // Use a pointer to allow for null values
+	Customer             *CustomerData      `json:"customer"`
+	BIN                  *string            `json:"bin"`   // Use a pointer to allow for null values
+	Last4                *string            `json:"last4"` // Use a pointer to allow for null values
+	Evidence             *Evidence          `json:"evidence"`
+	Attachments          interface{}        `json:"attachments"`
+	Note                 *string            `json:"note"` // Use a pointer to allow for null values
+	History              []DisputeHistory   `json:"history"`
+	Messages             []DisputeMessage   `json:"messages"`
+	DueAt                *string            `json:"dueAt"`      // Use a pointer to allow for null values
+	ResolvedAt           *string            `json:"resolvedAt"` // Use a pointer to allow for null values
+	CreatedAt            string             `json:"createdAt"`
+	UpdatedAt            string             `json:"updatedAt"`
+}
+
+type DisputeResponse struct {
+	Status  bool    `json:"status"`
+	Message string  `json:"message"`
+	Data    Dispute `json:"data"`
+}
+
+type DisputeListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Dispute       `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+type EvidenceResponse struct {
+	Status  bool     `json:"status"`
+	Message string   `json:"message"`
+	Data    Evidence `json:"data"`
+}
+
+type UploadURLResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		SignedURL string `json:"signedUrl"`
+		FileName  string `json:"fileName"`
+	} `json:"data"`
+}
+
+// disputePath returns the path of a dispute, followed by any extra segments.
+func disputePath(id int64, segments ...string) (string, error) {
+	if id <= 0 {
+		return "", errors.New("dispute id must be greater than zero")
+	}
+	path := "/dispute/" + strconv.FormatInt(id, 10)
+	for _, segment := range segments {
+		path += "/" + segment
+	}
+	return path, nil
+}
+
+func (p *Paystack) ListDisputes(filter ListDisputes) (*DisputeListResponse, error) {
+	return p.ListDisputesContext(context.Background(), filter)
+}
+
+// ListDisputesContext is like ListDisputes but aborts the call when ctx is cancelled.
+func (p *Paystack) ListDisputesContext(ctx context.Context, filter ListDisputes) (*DisputeListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[DisputeListResponse](ctx, p, "dispute.list", http.MethodGet, "/dispute", query, nil)
+}
+
+func (p *Paystack) FetchDispute(id int64) (*DisputeResponse, error) {
+	return p.FetchDisputeContext(context.Background(), id)
+}
+
+// FetchDisputeContext is like FetchDispute but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchDisputeContext(ctx context.Context, id int64) (*DisputeResponse, error) {
+	path, err := disputePath(id)
+	if err != nil {
+		return nil, err
+	}
+	return do[DisputeResponse](ctx, p, "dispute.fetch", http.MethodGet, path, nil, nil)
+}
+
+// ListTransactionDisputes returns the dispute raised on a transaction.
+func (p *Paystack) ListTransactionDisputes(transactionID int64) (*DisputeResponse, error) {
+	return p.ListTransactionDisputesContext(context.Background(), transactionID)
+}
+
+// ListTransactionDisputesContext is like ListTransactionDisputes but aborts the call when ctx is cancelled.
+func (p *Paystack) ListTransactionDisputesContext(ctx context.Context, transactionID int64) (*DisputeResponse, error) {
+	if transactionID <= 0 {
+		return nil, errors.New("transaction id must be greater than zero")
+	}
+	return do[DisputeResponse](ctx, p, "dispute.transaction", http.MethodGet, "/dispute/transaction/"+strconv.FormatInt(transactionID, 10), nil, nil)
+}
+
+func (p *Paystack) UpdateDispute(id int64, payload UpdateDisputeInput) (*DisputeResponse, error) {
+	return p.UpdateDisputeContext(context.Background(), id, payload)
+}
+
+// UpdateDisputeContext is like UpdateDispute but aborts the call when ctx is cancelled.
+func (p *Paystack) UpdateDisputeContext(ctx context.Context, id int64, payload UpdateDisputeInput) (*DisputeResponse, error) {
+	path, err := disputePath(id)
+	if err != nil {
+		return nil, err
+	}
+	//validate arguments
+	err = Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[DisputeResponse](ctx, p, "dispute.update", http.MethodPut, path, nil, payload)
+}
+
+// AddEvidence attaches proof of service to a dispute.
+func (p *Paystack) AddEvidence(id int64, payload EvidenceInput) (*EvidenceResponse, error) {
+	return p.AddEvidenceContext(context.Background(), id, payload)
+}
+
+// AddEvidenceContext is like AddEvidence but aborts the call when ctx is cancelled.
+func (p *Paystack) AddEvidenceContext(ctx context.Context, id int64, payload EvidenceInput) (*EvidenceResponse, error) {
+	path, err := disputePath(id, "evidence")
+	if err != nil {
+		return nil, err
+	}
+	//validate arguments
+	err = Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[EvidenceResponse](ctx, p, "dispute.evidence", http.MethodPost, path, nil, payload)
+}
+
+// GetUploadURL returns a signed URL to upload an attachment named fileName to.
+func (p *Paystack) GetUploadURL(id int64, fileName string) (*UploadURLResponse, error) {
+	return p.GetUploadURLContext(context.Background(), id, fileName)
+}
+
+// GetUploadURLContext is like GetUploadURL but aborts the call when ctx is cancelled.
+func (p *Paystack) GetUploadURLContext(ctx context.Context, id int64, fileName string) (*UploadURLResponse, error) {
+	path, err := disputePath(id, "upload_url")
+	if err != nil {
+		return nil, err
+	}
+	if fileName == "" {
+		return nil, errors.New("file name is required")
+	}
+	query := url.Values{"upload_filename": {fileName}}
+	return do[UploadURLResponse](ctx, p, "dispute.upload_url", http.MethodGet, path, query, nil)
+}
+
+// UploadDisputeFile uploads the local file at filePath as a dispute attachment
+// and returns the file name to pass as UploadedFilename when updating or
+// resolving the dispute.
+func (p *Paystack) UploadDisputeFile(id int64, filePath string) (string, error) {
+	return p.UploadDisputeFileContext(context.Background(), id, filePath)
+}
+
+// UploadDisputeFileContext is like UploadDisputeFile but aborts the upload when ctx is cancelled.
+func (p *Paystack) UploadDisputeFileContext(ctx context.Context, id int64, filePath string) (string, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", errors.New("Error reading file: " + err.Error())
+	}
+
+	upload, err := p.GetUploadURLContext(ctx, id, filepath.Base(filePath))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.Data.SignedURL, bytes.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(filePath)); contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// the signed URL carries its own credentials, so the secret key is not sent
+	resp, err := p.newRequest().Client().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, body)
+	}
+	return upload.Data.FileName, nil
+}
+
+// ResolveDispute accepts or declines a dispute.
+func (p *Paystack) ResolveDispute(id int64, payload ResolveDisputeInput) (*DisputeResponse, error) {
+	return p.ResolveDisputeContext(context.Background(), id, payload)
+}
+
+// ResolveDisputeContext is like ResolveDispute but aborts the call when ctx is cancelled.
+func (p *Paystack) ResolveDisputeContext(ctx context.Context, id int64, payload ResolveDisputeInput) (*DisputeResponse, error) {
+	path, err := disputePath(id, "resolve")
+	if err != nil {
+		return nil, err
+	}
+	//validate arguments
+	err = Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.Resolution == ResolutionDeclined && payload.Evidence == 0 {
+		return nil, errors.New("evidence is required to decline a dispute")
+	}
+	return do[DisputeResponse](ctx, p, "dispute.resolve", http.MethodPut, path, nil, payload)
+}
+
+// ExportDisputes generates a CSV export and returns the URL to download it from.
+func (p *Paystack) ExportDisputes(filter ListDisputes) (*ExportResponse, error) {
+	return p.ExportDisputesContext(context.Background(), filter)
+}
+
+// ExportDisputesContext is like ExportDisputes but aborts the call when ctx is cancelled.
+func (p *Paystack) ExportDisputesContext(ctx context.Context, filter ListDisputes) (*ExportResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[ExportResponse](ctx, p, "dispute.export", http.MethodGet, "/dispute/export", query, nil)
+}
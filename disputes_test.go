@@ -0,0 +1,90 @@
+package paystack
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUploadDisputeFile(t *testing.T) {
+	var uploaded []byte
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/dispute/624/upload_url":
+			if r.URL.Query().Get("upload_filename") != "receipt.pdf" {
+				t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"status":true,"message":"Upload url generated","data":{"signedUrl":"` + server.URL + `/signed/receipt.pdf?sig=abc","fileName":"qesp8a4df1xejihd9x5q.pdf"}}`))
+		case r.Method == "PUT" && r.URL.Path == "/signed/receipt.pdf":
+			if r.Header.Get("Authorization") != "" {
+				t.Errorf("Expected the secret key not to be sent to the signed URL")
+			}
+			if r.Header.Get("Content-Type") != "application/pdf" {
+				t.Errorf("Unexpected content type: %s", r.Header.Get("Content-Type"))
+			}
+			uploaded, _ = io.ReadAll(r.Body)
+		default:
+			t.Errorf("Unexpected call: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	filePath := filepath.Join(t.TempDir(), "receipt.pdf")
+	if err := os.WriteFile(filePath, []byte("%PDF-1.4"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+	fileName, err := p.UploadDisputeFile(624, filePath)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if fileName != "qesp8a4df1xejihd9x5q.pdf" || string(uploaded) != "%PDF-1.4" {
+		t.Errorf("Unexpected upload %q of %q", fileName, uploaded)
+	}
+}
+
+func TestResolveDispute(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "PUT", "/dispute/624/resolve",
+		`{"status":true,"message":"Dispute successfully resolved","data":{"id":624,"status":"resolved","resolution":"merchant-accepted","refund_amount":1002,"currency":"NGN"}}`,
+		&received)
+
+	refund := NewMoney(1002, NGN)
+	input := ResolveDisputeInput{
+		Resolution:       ResolutionMerchantAccepted,
+		Message:          "Merchant accepted",
+		RefundAmount:     &refund,
+		UploadedFilename: "qesp8a4df1xejihd9x5q.pdf",
+	}
+	resp, err := p.ResolveDispute(624, input)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Status != DisputeResolved || *resp.Data.Resolution != ResolutionMerchantAccepted || resp.Data.RefundAmount.Amount != 1002 {
+		t.Errorf("Unexpected dispute: %+v", resp.Data)
+	}
+	if received["refund_amount"] != float64(1002) {
+		t.Errorf("Unexpected payload: %v", received)
+	}
+
+	input.Resolution = ResolutionDeclined
+	if _, err := p.ResolveDispute(624, input); err == nil {
+		t.Errorf("Expected declining without evidence to be rejected")
+	}
+
+	// declining refunds nothing, so no refund_amount is sent
+	received = nil
+	input.RefundAmount = nil
+	input.Evidence = 21
+	if _, err := p.ResolveDispute(624, input); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if _, ok := received["refund_amount"]; ok {
+		t.Errorf("Expected refund_amount to be left out, but got: %v", received)
+	}
+}
@@ -0,0 +1,102 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when Paystack answers with a non-2xx status, or with
+// "status": false in an otherwise successful response.
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Message    string // Paystack's human readable message
+	Code       string // Paystack error code, e.g. "invalid_params"
+	Type       string // Paystack error type, e.g. "validation_error"
+	NextStep   string // suggested next step from meta.nextStep
+	Body       []byte // raw response body
+	RequestID  string // value of the X-Request-Id response header, if any
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("received non-200 response %d", e.StatusCode)
+	if e.StatusCode >= http.StatusOK && e.StatusCode < http.StatusMultipleChoices {
+		msg = fmt.Sprintf("paystack request failed (%d)", e.StatusCode)
+	}
+	if e.Code != "" {
+		msg += " (" + e.Code + ")"
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a failed response and its already read body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       body,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	var failed FailedTrasnferResponse
+	if err := json.Unmarshal(body, &failed); err != nil || failed.Message == "" {
+		// body is not Paystack's error envelope, fall back to the status text
+		apiErr.Message = http.StatusText(resp.StatusCode)
+		return apiErr
+	}
+	apiErr.Message = failed.Message
+	apiErr.Code = failed.Code
+	apiErr.Type = failed.Type
+	apiErr.NextStep = failed.Meta.NextStep
+	return apiErr
+}
+
+// asAPIError unwraps err into an APIError.
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsAuthError reports whether err was caused by a missing or invalid secret key.
+func IsAuthError(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// IsValidationError reports whether Paystack rejected the request parameters.
+func IsValidationError(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiErr.Type == "validation_error" ||
+		apiErr.StatusCode == http.StatusBadRequest ||
+		apiErr.StatusCode == http.StatusUnprocessableEntity
+}
+
+// IsNotFound reports whether the requested resource does not exist.
+func IsNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsRateLimited reports whether Paystack throttled the request.
+func IsRateLimited(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsRetryable reports whether the failure is transient and the call may be retried.
+func IsRetryable(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+}
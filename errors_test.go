@@ -0,0 +1,53 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_42")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":false,"message":"Invalid transfer code","meta":{"nextStep":"Check the code"},"type":"validation_error","code":"invalid_params"}`))
+	}))
+	defer server.Close()
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+	_, err := p.ConfirmTransfer(ConfirmTransferInput{TransferCode: "TRF_x", OTP: "123456"})
+
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		t.Fatalf("Expected an *APIError, but got: %v", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "invalid_params" || apiErr.Type != "validation_error" {
+		t.Errorf("Unexpected error fields: %+v", apiErr)
+	}
+	if apiErr.NextStep != "Check the code" || apiErr.RequestID != "req_42" {
+		t.Errorf("Expected next step and request id to be set, but got: %+v", apiErr)
+	}
+	if !IsValidationError(err) || IsAuthError(err) || IsRetryable(err) {
+		t.Errorf("Error classified incorrectly: %v", err)
+	}
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	cases := []struct {
+		status    int
+		auth      bool
+		limited   bool
+		retryable bool
+	}{
+		{http.StatusUnauthorized, true, false, false},
+		{http.StatusTooManyRequests, false, true, true},
+		{http.StatusBadGateway, false, false, true},
+		{http.StatusNotFound, false, false, false},
+	}
+	for _, c := range cases {
+		err := error(&APIError{StatusCode: c.status})
+		if IsAuthError(err) != c.auth || IsRateLimited(err) != c.limited || IsRetryable(err) != c.retryable {
+			t.Errorf("Status %d classified incorrectly", c.status)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package paystack
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// stubClient returns a client talking to a server that expects a single kind of
+// call and answers it with response. The decoded JSON body, if any, is stored in received.
+func stubClient(t *testing.T, method, path, response string, received *map[string]interface{}) (*Paystack, *http.Request) {
+	t.Helper()
+	var last http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.Path != path {
+			t.Errorf("Expected %s %s, but got: %s %s", method, path, r.Method, r.URL.Path)
+		}
+		if received != nil {
+			if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+				t.Errorf("Expected a JSON body, but got: %v", err)
+			}
+		}
+		last = *r
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return NewPaystackClient("api-key", WithBaseURL(server.URL)), &last
+}
@@ -0,0 +1,82 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func initializeServer(t *testing.T, received *map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+			t.Errorf("Expected a JSON body, but got: %v", err)
+		}
+		w.Write([]byte(`{"status":true,"message":"Authorization URL created","data":{"reference":"ref_1","authorization_url":"https://checkout.paystack.com/x","access_code":"x"}}`))
+	}))
+}
+
+func TestInitializeInput(t *testing.T) {
+	var received map[string]interface{}
+	server := initializeServer(t, &received)
+	defer server.Close()
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+	resp, err := p.Initialize(InitializeInput{
+		Email:       "johndoe@test.com",
+		Amount:      NewMoney(100000, NGN),
+		CallbackURL: "https://example.com/callback",
+		Channels:    []Channel{ChannelCard, ChannelBankTransfer},
+		Metadata:    map[string]interface{}{"order_id": "42"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Reference != "ref_1" {
+		t.Errorf("Expected reference 'ref_1', but got: %s", resp.Data.Reference)
+	}
+	if received["amount"] != float64(100000) || received["callback_url"] != "https://example.com/callback" {
+		t.Errorf("Unexpected payload: %v", received)
+	}
+	if received["metadata"] != `{"order_id":"42"}` {
+		t.Errorf("Expected metadata to be sent as stringified JSON, but got: %v", received["metadata"])
+	}
+	if _, ok := received["plan"]; ok {
+		t.Errorf("Expected empty optional fields to be omitted, but got: %v", received)
+	}
+}
+
+func TestInitializeInputValidation(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	if _, err := p.Initialize(InitializeInput{Email: "not-an-email", Amount: NewMoney(100, NGN)}); err == nil {
+		t.Errorf("Expected invalid email to be rejected")
+	}
+	if _, err := p.Initialize(InitializeInput{Email: "johndoe@test.com"}); err == nil {
+		t.Errorf("Expected missing amount to be rejected")
+	}
+	if _, err := p.Initialize(InitializeInput{Email: "johndoe@test.com", Amount: NewMoney(100, NGN), Channels: []Channel{"cash"}}); err == nil {
+		t.Errorf("Expected unknown channel to be rejected")
+	}
+	if _, err := p.Initialize(InitializeInput{Email: "johndoe@test.com", Amount: NewMoney(100, NGN), Currency: "GHS"}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch, but got: %v", err)
+	}
+}
+
+func TestInitializeMapAcceptsIntegerAmount(t *testing.T) {
+	var received map[string]interface{}
+	server := initializeServer(t, &received)
+	defer server.Close()
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+	_, err := p.Initialize(map[string]interface{}{
+		"email":  "johndoe@test.com",
+		"amount": 100000,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["amount"] != float64(100000) {
+		t.Errorf("Unexpected payload: %v", received)
+	}
+}
@@ -1,9 +1,16 @@
 package paystack
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/berryboylb/go_paystack_wrapper/requests"
+)
 
 type Paystack struct {
-	APIKey *string
+	APIKey         *string
+	requestOptions []requests.Option
+	middleware     []Middleware
 }
 
 type PostResponseData struct {
@@ -16,6 +23,44 @@ type PostResponseData struct {
 	} `json:"data"`
 }
 
+// Channel is a payment channel offered on the checkout page.
+type Channel string
+
+const (
+	ChannelCard         Channel = "card"
+	ChannelBank         Channel = "bank"
+	ChannelUSSD         Channel = "ussd"
+	ChannelQR           Channel = "qr"
+	ChannelMobileMoney  Channel = "mobile_money"
+	ChannelBankTransfer Channel = "bank_transfer"
+	ChannelEFT          Channel = "eft"
+)
+
+// Bearer decides who pays the Paystack fees on a split transaction.
+type Bearer string
+
+const (
+	BearerAccount    Bearer = "account"
+	BearerSubaccount Bearer = "subaccount"
+)
+
+// initialize transaction
+type InitializeInput struct {
+	Email             string                 `json:"email" validate:"required,email"`
+	Amount            Money                  `json:"amount" validate:"required_without=Plan,gte=0"`
+	Currency          string                 `json:"currency,omitempty" validate:"omitempty,len=3"`
+	Reference         string                 `json:"reference,omitempty"`
+	CallbackURL       string                 `json:"callback_url,omitempty" validate:"omitempty,url"`
+	Plan              string                 `json:"plan,omitempty"` // plan code, overrides Amount
+	InvoiceLimit      int                    `json:"invoice_limit,omitempty" validate:"gte=0"`
+	Metadata          map[string]interface{} `json:"-"`
+	Channels          []Channel              `json:"channels,omitempty" validate:"omitempty,dive,oneof=card bank ussd qr mobile_money bank_transfer eft"`
+	SplitCode         string                 `json:"split_code,omitempty"`
+	Subaccount        string                 `json:"subaccount,omitempty"`
+	TransactionCharge Money                  `json:"transaction_charge,omitempty" validate:"gte=0"`
+	Bearer            Bearer                 `json:"bearer,omitempty" validate:"omitempty,oneof=account subaccount"`
+}
+
 type GetResponseData struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
@@ -28,7 +73,7 @@ type TransactionData struct {
 	Domain             string                 `json:"domain"`
 	Status             string                 `json:"status"`
 	Reference          string                 `json:"reference"`
-	Amount             int                    `json:"amount"`
+	Amount             Money                  `json:"amount"`
 	Message            *string                `json:"message"` // Use a pointer to allow for null values
 	GatewayResponse    string                 `json:"gateway_response"`
 	PaidAt             time.Time              `json:"paid_at"`
@@ -36,24 +81,24 @@ type TransactionData struct {
 	Channel            string                 `json:"channel"`
 	Currency           string                 `json:"currency"`
 	IPAddress          string                 `json:"ip_address"`
-	Metadata           string                 `json:"metadata"`
+	Metadata           interface{}            `json:"metadata"` // string or object depending on what was sent
 	Log                LogData                `json:"log"`
-	Fees               int                    `json:"fees"`
+	Fees               Money                  `json:"fees"`
 	FeesSplit          *int                   `json:"fees_split"` // Use a pointer to allow for null values
 	Authorization      AuthorizationData      `json:"authorization"`
 	Customer           CustomerData           `json:"customer"`
-	Plan               *string                `json:"plan"` // Use a pointer to allow for null values
-	Split              map[string]interface{} `json:"split"`
+	Plan               TransactionPlan        `json:"plan"`     // zero when the payment was not for a plan
+	Split              Split                  `json:"split"`    // zero when no split was applied
 	OrderID            *string                `json:"order_id"` // Use a pointer to allow for null values
 	PaidAtISO          string                 `json:"paidAt"`
 	CreatedAtISO       string                 `json:"createdAt"`
-	RequestedAmount    int                    `json:"requested_amount"`
+	RequestedAmount    Money                  `json:"requested_amount"`
 	POSTransactionData *string                `json:"pos_transaction_data"` // Use a pointer to allow for null values
 	Source             *string                `json:"source"`               // Use a pointer to allow for null values
 	FeesBreakdown      *string                `json:"fees_breakdown"`       // Use a pointer to allow for null values
 	TransactionDate    string                 `json:"transaction_date"`
 	PlanObject         map[string]interface{} `json:"plan_object"`
-	Subaccount         map[string]interface{} `json:"subaccount"`
+	Subaccount         Subaccount             `json:"subaccount"` // zero when no subaccount was paid
 }
 
 type LogData struct {
@@ -90,15 +135,15 @@ type AuthorizationData struct {
 }
 
 type CustomerData struct {
-	ID                       int64   `json:"id"`
-	FirstName                *string `json:"first_name"` // Use a pointer to allow for null values
-	LastName                 *string `json:"last_name"`  // Use a pointer to allow for null values
-	Email                    string  `json:"email"`
-	CustomerCode             string  `json:"customer_code"`
-	Phone                    *string `json:"phone"`    // Use a pointer to allow for null values
-	Metadata                 *string `json:"metadata"` // Use a pointer to allow for null values
-	RiskAction               string  `json:"risk_action"`
-	InternationalFormatPhone *string `json:"international_format_phone"` // Use a pointer to allow for null values
+	ID                       int64       `json:"id"`
+	FirstName                *string     `json:"first_name"` // Use a pointer to allow for null values
+	LastName                 *string     `json:"last_name"`  // Use a pointer to allow for null values
+	Email                    string      `json:"email"`
+	CustomerCode             string      `json:"customer_code"`
+	Phone                    *string     `json:"phone"`    // Use a pointer to allow for null values
+	Metadata                 interface{} `json:"metadata"` // null, string or object
+	RiskAction               string      `json:"risk_action"`
+	InternationalFormatPhone *string     `json:"international_format_phone"` // Use a pointer to allow for null values
 }
 
 // listbanks
@@ -131,105 +176,99 @@ type ListTransactions struct {
 	Customer   string            `json:"customer" schema:"customer"`
 	TerminalID string            `json:"terminalid" schema:"terminalid"`
 	Status     TransactionStatus `json:"status" schema:"status"`
-	Amount     int               `json:"amount" schema:"amount"`
+	Amount     Money             `json:"amount" schema:"amount"`
 	To         *time.Time        `json:"to" schema:"to" validate:"omitempty,timestamp"`
 	From       *time.Time        `json:"from" schema:"from" validate:"omitempty,timestamp"`
 }
 
 type FullResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    []struct {
-		ID              int64       `json:"id"`
-		Domain          string      `json:"domain"`
-		Status          string      `json:"status"`
-		Reference       string      `json:"reference"`
-		Amount          int         `json:"amount"`
-		Message         interface{} `json:"message"`
-		GatewayResponse string      `json:"gateway_response"`
-		PaidAt          time.Time   `json:"paid_at"`
-		CreatedAt       time.Time   `json:"created_at"`
-		Channel         string      `json:"channel"`
-		Currency        string      `json:"currency"`
-		IPAddress       string      `json:"ip_address"`
-		Metadata        interface{} `json:"metadata"`
-		Log             struct {
-			StartTime int           `json:"start_time"`
-			TimeSpent int           `json:"time_spent"`
-			Attempts  int           `json:"attempts"`
-			Errors    int           `json:"errors"`
-			Success   bool          `json:"success"`
-			Mobile    bool          `json:"mobile"`
-			Input     []interface{} `json:"input"`
-			History   []struct {
-				Type    string `json:"type"`
-				Message string `json:"message"`
-				Time    int    `json:"time"`
-			} `json:"history"`
-		} `json:"log"`
-		Fees      int         `json:"fees"`
-		FeesSplit interface{} `json:"fees_split"`
-		Customer  struct {
-			ID           int         `json:"id"`
-			FirstName    interface{} `json:"first_name"`
-			LastName     interface{} `json:"last_name"`
-			Email        string      `json:"email"`
-			Phone        interface{} `json:"phone"`
-			Metadata     interface{} `json:"metadata"`
-			CustomerCode string      `json:"customer_code"`
-			RiskAction   string      `json:"risk_action"`
-		} `json:"customer"`
-		Authorization struct {
-			AuthorizationCode string      `json:"authorization_code"`
-			Bin               string      `json:"bin"`
-			Last4             string      `json:"last4"`
-			ExpMonth          string      `json:"exp_month"`
-			ExpYear           string      `json:"exp_year"`
-			Channel           string      `json:"channel"`
-			CardType          string      `json:"card_type"`
-			Bank              string      `json:"bank"`
-			CountryCode       string      `json:"country_code"`
-			Brand             string      `json:"brand"`
-			Reusable          bool        `json:"reusable"`
-			Signature         string      `json:"signature"`
-			AccountName       interface{} `json:"account_name"`
-		} `json:"authorization"`
-		Plan struct {
-		} `json:"plan"`
-		Split struct {
-		} `json:"split"`
-		Subaccount struct {
-		} `json:"subaccount"`
-		OrderID         interface{} `json:"order_id"`
-		PaidAtSec          time.Time   `json:"paidAt"`
-		CreatedAtSec       time.Time   `json:"createdAt"`
-		RequestedAmount int         `json:"requested_amount"`
-		Source          struct {
-			Source     string      `json:"source"`
-			Type       string      `json:"type"`
-			Identifier interface{} `json:"identifier"`
-			EntryPoint string      `json:"entry_point"`
-		} `json:"source"`
-		ConnectData struct {
-		} `json:"connect_data"`
-		PosTransactionData interface{} `json:"pos_transaction_data"`
-	} `json:"data"`
-	Meta struct {
-		Total       int    `json:"total"`
-		TotalVolume int    `json:"total_volume"`
-		Skipped     int    `json:"skipped"`
-		PerPage     string `json:"perPage"`
-		Page        int    `json:"page"`
-		PageCount   int    `json:"pageCount"`
-	} `json:"meta"`
+	Status  bool                `json:"status"`
+	Message string              `json:"message"`
+	Data    []ListedTransaction `json:"data"`
+	Meta    MetaTransaction     `json:"meta"`
+}
+
+// ListedTransaction is a transaction as returned by the list endpoint.
+type ListedTransaction struct {
+	ID              int64       `json:"id"`
+	Domain          string      `json:"domain"`
+	Status          string      `json:"status"`
+	Reference       string      `json:"reference"`
+	Amount          Money       `json:"amount"`
+	Message         interface{} `json:"message"`
+	GatewayResponse string      `json:"gateway_response"`
+	PaidAt          time.Time   `json:"paid_at"`
+	CreatedAt       time.Time   `json:"created_at"`
+	Channel         string      `json:"channel"`
+	Currency        string      `json:"currency"`
+	IPAddress       string      `json:"ip_address"`
+	Metadata        interface{} `json:"metadata"`
+	Log             struct {
+		StartTime int           `json:"start_time"`
+		TimeSpent int           `json:"time_spent"`
+		Attempts  int           `json:"attempts"`
+		Errors    int           `json:"errors"`
+		Success   bool          `json:"success"`
+		Mobile    bool          `json:"mobile"`
+		Input     []interface{} `json:"input"`
+		History   []struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+			Time    int    `json:"time"`
+		} `json:"history"`
+	} `json:"log"`
+	Fees      Money       `json:"fees"`
+	FeesSplit interface{} `json:"fees_split"`
+	Customer  struct {
+		ID           int         `json:"id"`
+		FirstName    interface{} `json:"first_name"`
+		LastName     interface{} `json:"last_name"`
+		Email        string      `json:"email"`
+		Phone        interface{} `json:"phone"`
+		Metadata     interface{} `json:"metadata"`
+		CustomerCode string      `json:"customer_code"`
+		RiskAction   string      `json:"risk_action"`
+	} `json:"customer"`
+	Authorization struct {
+		AuthorizationCode string      `json:"authorization_code"`
+		Bin               string      `json:"bin"`
+		Last4             string      `json:"last4"`
+		ExpMonth          string      `json:"exp_month"`
+		ExpYear           string      `json:"exp_year"`
+		Channel           string      `json:"channel"`
+		CardType          string      `json:"card_type"`
+		Bank              string      `json:"bank"`
+		CountryCode       string      `json:"country_code"`
+		Brand             string      `json:"brand"`
+		Reusable          bool        `json:"reusable"`
+		Signature         string      `json:"signature"`
+		AccountName       interface{} `json:"account_name"`
+	} `json:"authorization"`
+	Plan            TransactionPlan `json:"plan"`
+	Split           Split           `json:"split"`
+	Subaccount      Subaccount      `json:"subaccount"`
+	OrderID         interface{}     `json:"order_id"`
+	PaidAtSec       time.Time       `json:"paidAt"`
+	CreatedAtSec    time.Time       `json:"createdAt"`
+	RequestedAmount Money           `json:"requested_amount"`
+	Source          struct {
+		Source     string      `json:"source"`
+		Type       string      `json:"type"`
+		Identifier interface{} `json:"identifier"`
+		EntryPoint string      `json:"entry_point"`
+	} `json:"source"`
+	ConnectData struct {
+	} `json:"connect_data"`
+	PosTransactionData interface{} `json:"pos_transaction_data"`
 }
 
 type MetaTransaction struct {
-	Total     int    `json:"total"`
-	Skipped   int    `json:"skipped"`
-	PerPage   string `json:"perPage"`
-	Page      int    `json:"page"`
-	PageCount int    `json:"pageCount"`
+	Total       int         `json:"total"`
+	TotalVolume int         `json:"total_volume"`
+	Skipped     int         `json:"skipped"`
+	PerPage     json.Number `json:"perPage"` // sent as a number or a string depending on the endpoint
+	Page        int         `json:"page"`
+	PageCount   int         `json:"pageCount"`
 }
 
 type BankResponse struct {
@@ -264,30 +303,20 @@ type BankMeta struct {
 }
 
 type TransferInput struct {
-	Amount    float64 `json:"amount" schema:"amount" validate:"required"`
-	Recipient string  `json:"recipient" schema:"recipient" validate:"required"`
-	Reason    string  `json:"reason" schema:"recipient" validate:"required"`
-	Currency  string  `json:"currency"`
-	Reference string  `json:"reference"`
+	Amount    Money  `json:"amount" schema:"amount" validate:"required,gt=0"`
+	Recipient string `json:"recipient" schema:"recipient" validate:"required"`
+	Reason    string `json:"reason" schema:"recipient" validate:"required"`
+	Currency  string `json:"currency"`
+	Reference string `json:"reference" validate:"required_without=IdempotencyKey"`
+	// IdempotencyKey derives Reference when it is empty, so that retrying the
+	// same logical transfer can never pay twice. See TransferReference.
+	IdempotencyKey string `json:"-"`
 }
 
 type InitTransferResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    struct {
-		Integration  int       `json:"integration"`
-		Domain       string    `json:"domain"`
-		Amount       int       `json:"amount"`
-		Currency     string    `json:"currency"`
-		Source       string    `json:"source"`
-		Reason       string    `json:"reason"`
-		Recipient    int       `json:"recipient"`
-		Status       string    `json:"status"`
-		TransferCode string    `json:"transfer_code"`
-		ID           int       `json:"id"`
-		CreatedAt    time.Time `json:"createdAt"`
-		UpdatedAt    time.Time `json:"updatedAt"`
-	} `json:"data"`
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    TransferData `json:"data"`
 }
 
 type ConfirmTransferInput struct {
@@ -296,30 +325,49 @@ type ConfirmTransferInput struct {
 }
 
 type ConfirmTransferResponse struct {
-	Status  bool   `json:"status"`
-	Message string `json:"message"`
-	Data    struct {
-		Domain        string      `json:"domain"`
-		Amount        int         `json:"amount"`
-		Currency      string      `json:"currency"`
-		Reference     string      `json:"reference"`
-		Source        string      `json:"source"`
-		SourceDetails interface{} `json:"source_details"`
-		Reason        string      `json:"reason"`
-		Status        string      `json:"status"`
-		Failures      interface{} `json:"failures"`
-		TransferCode  string      `json:"transfer_code"`
-		TitanCode     interface{} `json:"titan_code"`
-		TransferredAt interface{} `json:"transferred_at"`
-		ID            int         `json:"id"`
-		Integration   int         `json:"integration"`
-		Recipient     int         `json:"recipient"`
-		CreatedAt     time.Time   `json:"createdAt"`
-		UpdatedAt     time.Time   `json:"updatedAt"`
-	} `json:"data"`
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    TransferData `json:"data"`
+}
+
+type TransferStatus string
+
+const (
+	TransferPending  TransferStatus = "pending"
+	TransferSuccess  TransferStatus = "success"
+	TransferFailed   TransferStatus = "failed"
+	TransferReversed TransferStatus = "reversed"
+	TransferOTP      TransferStatus = "otp" // waiting for ConfirmTransfer
+	TransferReceived TransferStatus = "received"
+)
+
+type TransferData struct {
+	Integration   int               `json:"integration"`
+	Domain        string            `json:"domain"`
+	Amount        Money             `json:"amount"`
+	Currency      string            `json:"currency"`
+	Reference     string            `json:"reference"`
+	Source        string            `json:"source"`
+	SourceDetails interface{}       `json:"source_details"`
+	Reason        string            `json:"reason"`
+	Recipient     TransferRecipient `json:"recipient"`
+	Status        TransferStatus    `json:"status"`
+	Failures      interface{}       `json:"failures"`
+	TransferCode  string            `json:"transfer_code"`
+	TitanCode     interface{}       `json:"titan_code"`
+	TransferredAt interface{}       `json:"transferred_at"`
+	ID            int               `json:"id"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+}
+
+// TransferRecipient is the recipient of a transfer. Paystack sends only its ID
+// when initiating a transfer and the whole recipient when fetching one.
+type TransferRecipient struct {
+	RecipientData
 }
 
-//failed transfer struct type Response struct {
+// failed transfer struct type Response struct {
 type FailedTrasnferResponse struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
@@ -330,42 +378,75 @@ type FailedTrasnferResponse struct {
 	Code string `json:"code"`
 }
 
-//create recipient
+// RecipientType is the kind of account a transfer recipient is paid into.
+type RecipientType string
+
+const (
+	RecipientNUBAN         RecipientType = "nuban"         // Nigerian bank account
+	RecipientGHIPSS        RecipientType = "ghipss"        // Ghanaian bank account
+	RecipientMobileMoney   RecipientType = "mobile_money"  // mobile money wallet, BankCode is the provider
+	RecipientBASA          RecipientType = "basa"          // South African bank account
+	RecipientAuthorization RecipientType = "authorization" // a customer's saved card authorization
+)
+
+// create recipient, which fields are required depends on Type
 type AccountDetails struct {
-	Type          string `json:"type" schema:"type" validate:"required"`
-	Name          string `json:"name" schema:"name" validate:"required"`
-	AccountNumber string `json:"account_number" schema:"account_number" validate:"required"`
-	BankCode      string `json:"bank_code" schema:"bank_code" validate:"required"`
-	Currency      string `json:"currency" schema:"currency" validate:"required"`
-	Description   string `json:"description" schema:"description" validate:"required"`
+	Type              RecipientType          `json:"type" schema:"type" validate:"required,oneof=nuban ghipss mobile_money basa authorization"`
+	Name              string                 `json:"name" schema:"name" validate:"required"`
+	AccountNumber     string                 `json:"account_number,omitempty" schema:"account_number" validate:"required_unless=Type authorization"`
+	BankCode          string                 `json:"bank_code,omitempty" schema:"bank_code" validate:"required_unless=Type authorization"`
+	Currency          string                 `json:"currency" schema:"currency" validate:"required"`
+	Description       string                 `json:"description,omitempty" schema:"description"`
+	AuthorizationCode string                 `json:"authorization_code,omitempty" schema:"authorization_code" validate:"required_if=Type authorization"`
+	Email             string                 `json:"email,omitempty" schema:"email" validate:"required_if=Type authorization,omitempty,email"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty" schema:"metadata"`
 }
 
 type Recipient struct {
+	Status  bool          `json:"status"`
+	Message string        `json:"message"`
+	Data    RecipientData `json:"data"`
+}
+
+type RecipientData struct {
+	Active        bool          `json:"active"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	Currency      string        `json:"currency"`
+	Description   string        `json:"description"`
+	Domain        string        `json:"domain"`
+	Email         *string       `json:"email"` // Use a pointer to allow for null values
+	ID            int           `json:"id"`
+	Integration   int           `json:"integration"`
+	Metadata      interface{}   `json:"metadata"`
+	Name          string        `json:"name"`
+	RecipientCode string        `json:"recipient_code"`
+	Type          RecipientType `json:"type"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+	IsDeleted     bool          `json:"is_deleted"`
+	Details       struct {
+		AuthorizationCode *string `json:"authorization_code"`
+		AccountNumber     string  `json:"account_number"`
+		AccountName       *string `json:"account_name"`
+		BankCode          string  `json:"bank_code"`
+		BankName          string  `json:"bank_name"`
+	} `json:"details"`
+}
+
+type Error struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
-	Data    struct {
-		Active        bool      `json:"active"`
-		CreatedAt     time.Time `json:"createdAt"`
-		Currency      string    `json:"currency"`
-		Domain        string    `json:"domain"`
-		ID            int       `json:"id"`
-		Integration   int       `json:"integration"`
-		Name          string    `json:"name"`
-		RecipientCode string    `json:"recipient_code"`
-		Type          string    `json:"type"`
-		UpdatedAt     time.Time `json:"updatedAt"`
-		IsDeleted     bool      `json:"is_deleted"`
-		Details       struct {
-			AuthorizationCode *string `json:"authorization_code"`
-			AccountNumber     string  `json:"account_number"`
-			AccountName       *string `json:"account_name"`
-			BankCode          string  `json:"bank_code"`
-			BankName          string  `json:"bank_name"`
-		} `json:"details"`
-	} `json:"data"`
 }
 
-type Error struct {
+// MessageResponse is returned by endpoints that only acknowledge the call.
+type MessageResponse struct {
 	Status  bool   `json:"status"`
 	Message string `json:"message"`
 }
+
+// PageFilter holds the pagination and date range parameters shared by list endpoints.
+type PageFilter struct {
+	PerPage int        `json:"perPage" schema:"perPage" validate:"omitempty,min=1"`
+	Page    int        `json:"page" schema:"page" validate:"omitempty,min=1"`
+	From    *time.Time `json:"from" schema:"from" validate:"omitempty,timestamp"`
+	To      *time.Time `json:"to" schema:"to" validate:"omitempty,timestamp"`
+}
@@ -0,0 +1,192 @@
+package paystack
+
+import (
+	"context"
+)
+
+// pageFunc fetches the next page of a list, reporting whether more pages follow.
+type pageFunc[T any] func(ctx context.Context) (items []T, more bool, err error)
+
+type page[T any] struct {
+	items []T
+	more  bool
+	err   error
+}
+
+// Iterator walks every item of a paginated list, fetching pages on demand.
+//
+//	it := client.TransactionsIter(ctx, ListTransactions{PerPage: 50})
+//	for it.Next() {
+//		fmt.Println(it.Item().Reference)
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle error
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	fetch    pageFunc[T]
+	prefetch bool
+
+	items   []T
+	index   int
+	more    bool
+	pending chan page[T]
+	current T
+	err     error
+}
+
+// IterOption configures an Iterator.
+type IterOption func(*iterConfig)
+
+type iterConfig struct {
+	prefetch bool
+}
+
+// Prefetch fetches the next page in the background while the current one is consumed.
+func Prefetch() IterOption {
+	return func(c *iterConfig) {
+		c.prefetch = true
+	}
+}
+
+func newIterator[T any](ctx context.Context, fetch pageFunc[T], opts []IterOption) *Iterator[T] {
+	var config iterConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return &Iterator[T]{ctx: ctx, fetch: fetch, prefetch: config.prefetch, more: true}
+}
+
+// Next advances to the next item, fetching a new page when needed. It returns
+// false when the list is exhausted, an error occurred or the context is done.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	for it.index >= len(it.items) {
+		if !it.more && it.pending == nil {
+			return false
+		}
+		result := it.nextPage()
+		if result.err != nil {
+			it.err = result.err
+			return false
+		}
+		it.items, it.index, it.more = result.items, 0, result.more
+		if len(it.items) == 0 {
+			// an empty page ends the list whatever the metadata says
+			it.more = false
+			return false
+		}
+		if it.prefetch && it.more {
+			it.startPrefetch()
+		}
+	}
+
+	it.current = it.items[it.index]
+	it.index++
+	return true
+}
+
+// nextPage returns the prefetched page or fetches one.
+func (it *Iterator[T]) nextPage() page[T] {
+	if it.pending != nil {
+		pending := it.pending
+		it.pending = nil
+		select {
+		case result := <-pending:
+			return result
+		case <-it.ctx.Done():
+			return page[T]{err: it.ctx.Err()}
+		}
+	}
+	items, more, err := it.fetch(it.ctx)
+	return page[T]{items: items, more: more, err: err}
+}
+
+// startPrefetch fetches the following page in the background.
+func (it *Iterator[T]) startPrefetch() {
+	// buffered so the goroutine never blocks if the iterator is abandoned
+	pending := make(chan page[T], 1)
+	it.pending = pending
+	it.more = false
+	go func() {
+		items, more, err := it.fetch(it.ctx)
+		pending <- page[T]{items: items, more: more, err: err}
+	}()
+}
+
+// Item returns the current item. Only valid after Next returned true.
+func (it *Iterator[T]) Item() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All drains the iterator into a slice.
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// TransactionsIter iterates over every transaction matching filter, page by
+// page, starting at filter.Page. PerPage defaults to 50.
+func (p *Paystack) TransactionsIter(ctx context.Context, filter ListTransactions, opts ...IterOption) *Iterator[ListedTransaction] {
+	if filter.Page == 0 {
+		filter.Page = 1
+	}
+	if filter.PerPage == 0 {
+		filter.PerPage = 50
+	}
+	fetch := func(ctx context.Context) ([]ListedTransaction, bool, error) {
+		resp, err := p.ListTransactionsContext(ctx, filter)
+		if err != nil {
+			return nil, false, err
+		}
+		more := filter.Page < resp.Meta.PageCount
+		filter.Page++
+		return resp.Data, more, nil
+	}
+	return newIterator[ListedTransaction](ctx, fetch, opts)
+}
+
+// AllTransactions returns every transaction matching filter.
+func (p *Paystack) AllTransactions(ctx context.Context, filter ListTransactions) ([]ListedTransaction, error) {
+	return p.TransactionsIter(ctx, filter).All()
+}
+
+// BanksIter iterates over every bank matching filter, following the
+// Meta.Next cursor. UseCursor defaults to "true" and PerPage to 50.
+func (p *Paystack) BanksIter(ctx context.Context, filter FilterBanks, opts ...IterOption) *Iterator[Bank] {
+	if filter.UseCursor == "" {
+		filter.UseCursor = "true"
+	}
+	if filter.PerPage == 0 {
+		filter.PerPage = 50
+	}
+	fetch := func(ctx context.Context) ([]Bank, bool, error) {
+		resp, err := p.ListBanksContext(ctx, filter)
+		if err != nil {
+			return nil, false, err
+		}
+		filter.Next = resp.Meta.Next
+		return resp.Data, resp.Meta.Next != "", nil
+	}
+	return newIterator[Bank](ctx, fetch, opts)
+}
+
+// AllBanks returns every bank matching filter.
+func (p *Paystack) AllBanks(ctx context.Context, filter FilterBanks) ([]Bank, error) {
+	return p.BanksIter(ctx, filter).All()
+}
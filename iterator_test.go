@@ -0,0 +1,81 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func transactionPages(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if r.URL.Query().Get("perPage") != "10" {
+			t.Errorf("Expected perPage=10, but got: %s", r.URL.RawQuery)
+		}
+		fmt.Fprintf(w, `{"status":true,"message":"Transactions retrieved","data":[{"reference":"ref_%s_a","currency":"NGN","amount":100},{"reference":"ref_%s_b","currency":"NGN","amount":200}],"meta":{"total":6,"perPage":10,"page":%s,"pageCount":3}}`, page, page, page)
+	}))
+}
+
+func TestTransactionsIter(t *testing.T) {
+	server := transactionPages(t)
+	defer server.Close()
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+
+	for _, opts := range [][]IterOption{nil, {Prefetch()}} {
+		it := p.TransactionsIter(context.Background(), ListTransactions{PerPage: 10}, opts...)
+		var references []string
+		for it.Next() {
+			references = append(references, it.Item().Reference)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		want := []string{"ref_1_a", "ref_1_b", "ref_2_a", "ref_2_b", "ref_3_a", "ref_3_b"}
+		if fmt.Sprint(references) != fmt.Sprint(want) {
+			t.Errorf("Expected %v, but got: %v", want, references)
+		}
+	}
+}
+
+func TestTransactionsIterStopsOnCancel(t *testing.T) {
+	server := transactionPages(t)
+	defer server.Close()
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := p.TransactionsIter(ctx, ListTransactions{PerPage: 10})
+	it.Next()
+	cancel()
+	if it.Next() {
+		t.Errorf("Expected iteration to stop after cancel")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, but got: %v", it.Err())
+	}
+}
+
+func TestAllBanksFollowsCursor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("next") {
+		case "":
+			w.Write([]byte(`{"status":true,"message":"Banks retrieved","data":[{"name":"Access Bank"}],"meta":{"next":"YmFuazoz","perPage":1}}`))
+		case "YmFuazoz":
+			w.Write([]byte(`{"status":true,"message":"Banks retrieved","data":[{"name":"Zenith Bank"}],"meta":{"next":"","previous":"YmFuazoy","perPage":1}}`))
+		default:
+			t.Errorf("Unexpected cursor: %s", r.URL.RawQuery)
+		}
+	}))
+	defer server.Close()
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+
+	banks, err := p.AllBanks(context.Background(), FilterBanks{Country: "nigeria", PerPage: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(banks) != 2 || banks[0].Name != "Access Bank" || banks[1].Name != "Zenith Bank" {
+		t.Errorf("Unexpected banks: %+v", banks)
+	}
+}
@@ -0,0 +1,50 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+)
+
+// Call describes one outbound Paystack call as seen by middleware.
+type Call struct {
+	Endpoint   string      // logical endpoint name, e.g. "transaction.verify"
+	Method     string      // HTTP method
+	Path       string      // request path including the query string
+	Header     http.Header // extra headers sent with the call
+	Body       interface{} // request payload, nil when the call has no body
+	StatusCode int         // HTTP status, set once a response was received
+	Response   interface{} // pointer the response is decoded into, only complete once the call succeeded
+}
+
+// Doer performs a Call.
+type Doer interface {
+	Do(ctx context.Context, call *Call) error
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(ctx context.Context, call *Call) error
+
+// Do calls f(ctx, call).
+func (f DoerFunc) Do(ctx context.Context, call *Call) error {
+	return f(ctx, call)
+}
+
+// Middleware wraps a Doer with cross-cutting behaviour such as logging or metrics.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware registers middleware wrapping every outbound call. The first
+// middleware given is the outermost one.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(p *Paystack) {
+		p.middleware = append(p.middleware, middleware...)
+	}
+}
+
+// chain wraps core with the client's middleware.
+func (p *Paystack) chain(core Doer) Doer {
+	doer := core
+	for i := len(p.middleware) - 1; i >= 0; i-- {
+		doer = p.middleware[i](doer)
+	}
+	return doer
+}
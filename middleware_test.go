@@ -0,0 +1,54 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Audit"); got != "on" {
+			t.Errorf("Expected header injected by middleware, but got: %q", got)
+		}
+		w.Write([]byte(`{"status":true,"message":"Verification successful","data":{"reference":"ref_1"}}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	tracer := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(ctx context.Context, call *Call) error {
+				order = append(order, name+":"+call.Endpoint)
+				call.Header.Set("X-Audit", "on")
+				err := next.Do(ctx, call)
+				if resp, ok := call.Response.(*GetResponseData); !ok || resp.Data.Reference != "ref_1" {
+					t.Errorf("Expected decoded response to be visible to middleware, but got: %+v", call.Response)
+				}
+				return err
+			})
+		}
+	}
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL), WithMiddleware(tracer("outer"), tracer("inner")))
+	if _, err := p.Verify("ref_1"); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(order) != 2 || order[0] != "outer:transaction.verify" || order[1] != "inner:transaction.verify" {
+		t.Errorf("Unexpected middleware order: %v", order)
+	}
+}
+
+func TestMiddlewareCanShortCircuit(t *testing.T) {
+	chaos := errors.New("chaos")
+	p := NewPaystackClient("api-key", WithMiddleware(func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) error {
+			return chaos
+		})
+	}))
+	if _, err := p.Verify("ref_1"); !errors.Is(err, chaos) {
+		t.Errorf("Expected middleware error, but got: %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package paystack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code supported by Paystack.
+type Currency string
+
+const (
+	NGN Currency = "NGN"
+	GHS Currency = "GHS"
+	ZAR Currency = "ZAR"
+	KES Currency = "KES"
+	USD Currency = "USD"
+)
+
+// every supported currency has 100 subunits (kobo, pesewas, cents)
+const subunits = 100
+
+var currencySymbols = map[Currency]string{
+	NGN: "₦",
+	GHS: "GH₵",
+	ZAR: "R",
+	KES: "KSh",
+	USD: "$",
+}
+
+// ErrCurrencyMismatch is returned when combining amounts in different currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money is an amount in the currency's subunit (kobo, pesewa, cent). It is
+// encoded to and decoded from JSON as the bare integer Paystack uses, so the
+// currency travels in the surrounding struct's currency field.
+type Money struct {
+	Amount   int64 // minor units, e.g. kobo
+	Currency Currency
+}
+
+// NewMoney returns an amount given in minor units, e.g. NewMoney(150000, NGN) is ₦1,500.00.
+func NewMoney(minor int64, currency Currency) Money {
+	return Money{Amount: minor, Currency: currency}
+}
+
+// MajorUnits returns an amount given in whole units, e.g. MajorUnits(1500, NGN) is ₦1,500.00.
+func MajorUnits(major int64, currency Currency) Money {
+	return Money{Amount: major * subunits, Currency: currency}
+}
+
+// digits matches the whole or fraction part of an amount.
+var digits = regexp.MustCompile(`^[0-9]+$`)
+
+// thousands matches a whole part grouped with commas, e.g. "1,500,000".
+var thousands = regexp.MustCompile(`^[0-9]{1,3}(,[0-9]{3})+$`)
+
+// ParseMoney parses a decimal amount in major units such as "1500.50" without
+// going through floating point. Commas are only accepted as thousands separators.
+func ParseMoney(value string, currency Currency) (Money, error) {
+	input := strings.TrimSpace(value)
+	unsigned := strings.TrimPrefix(input, "-")
+	negative := unsigned != input
+
+	whole, fraction, hasFraction := strings.Cut(unsigned, ".")
+	if thousands.MatchString(whole) {
+		whole = strings.ReplaceAll(whole, ",", "")
+	}
+	if !digits.MatchString(whole) || (hasFraction && !digits.MatchString(fraction)) || len(fraction) > 2 {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+	minor, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q", value)
+	}
+
+	amount := major*subunits + minor
+	if negative {
+		amount = -amount
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Add returns m + other. Both amounts must be in the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Sub returns m - other. Both amounts must be in the same currency.
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// Split divides m into n parts that differ by at most one subunit and add up
+// to m exactly. The first parts receive the remainder.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, errors.New("cannot split into fewer than one part")
+	}
+	share := m.Amount / int64(n)
+	remainder := m.Amount % int64(n)
+
+	parts := make([]Money, n)
+	for i := range parts {
+		parts[i] = Money{Amount: share, Currency: m.Currency}
+		if remainder > 0 {
+			parts[i].Amount++
+			remainder--
+		} else if remainder < 0 {
+			parts[i].Amount--
+			remainder++
+		}
+	}
+	return parts, nil
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// String formats the amount in major units with the currency symbol, e.g. "₦1,500.50".
+func (m Money) String() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	whole := strconv.FormatInt(amount/subunits, 10)
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	symbol, ok := currencySymbols[m.Currency]
+	if !ok {
+		symbol = string(m.Currency) + " "
+	}
+	return fmt.Sprintf("%s%s%s.%02d", sign, symbol, grouped.String(), amount%subunits)
+}
+
+// MarshalJSON encodes the amount as Paystack's integer subunits.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(m.Amount, 10)), nil
+}
+
+// UnmarshalJSON decodes Paystack's integer subunits, also accepting them as a
+// string. The currency is left untouched.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if string(data) == "null" || len(data) == 0 {
+		m.Amount = 0
+		return nil
+	}
+
+	if amount, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		m.Amount = amount
+		return nil
+	}
+	// some endpoints send whole amounts as floats, e.g. 5000.0
+	f, err := strconv.ParseFloat(string(data), 64)
+	if err != nil || f != float64(int64(f)) {
+		return fmt.Errorf("invalid amount %s", data)
+	}
+	m.Amount = int64(f)
+	return nil
+}
+
+// resolveCurrency returns the currency to send with amount: currency when set,
+// otherwise the amount's own. Conflicting currencies are an error.
+func resolveCurrency(currency string, amount Money) (string, error) {
+	if currency == "" {
+		return string(amount.Currency), nil
+	}
+	if amount.Currency != "" && currency != string(amount.Currency) {
+		return "", fmt.Errorf("%w: amount is in %s but currency is %s", ErrCurrencyMismatch, amount.Currency, currency)
+	}
+	return currency, nil
+}
@@ -0,0 +1,84 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	cases := map[string]int64{
+		"1500":      150000,
+		"1,500.5":   150050,
+		"0.07":      7,
+		"-12.30":    -1230,
+		"100000.99": 10000099,
+		"1,500,000": 150000000,
+		" 7 ":       700,
+	}
+	for input, want := range cases {
+		got, err := ParseMoney(input, NGN)
+		if err != nil || got.Amount != want {
+			t.Errorf("ParseMoney(%q) = %v, %v; want %d", input, got.Amount, err, want)
+		}
+	}
+	for _, input := range []string{"1.234", "--5", "-+5", "1.+5", "1.-5", "1,2,3", "12,34", ",100", "1.", ".5", "1.2.3", "1 000", ""} {
+		if got, err := ParseMoney(input, NGN); err == nil {
+			t.Errorf("Expected ParseMoney(%q) to be rejected, but got: %v", input, got)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	total, err := MajorUnits(10, NGN).Add(NewMoney(50, NGN))
+	if err != nil || total.Amount != 1050 {
+		t.Errorf("Expected 1050, but got: %v, %v", total.Amount, err)
+	}
+	if _, err := total.Sub(NewMoney(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch, but got: %v", err)
+	}
+
+	parts, err := NewMoney(1000, NGN).Split(3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if parts[0].Amount != 334 || parts[1].Amount != 333 || parts[2].Amount != 333 {
+		t.Errorf("Unexpected split: %v", parts)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	cases := map[Money]string{
+		NewMoney(150050, NGN):    "₦1,500.50",
+		NewMoney(5, GHS):         "GH₵0.05",
+		NewMoney(123456789, ZAR): "R1,234,567.89",
+		NewMoney(-100, KES):      "-KSh1.00",
+		NewMoney(999, USD):       "$9.99",
+		NewMoney(100, "XOF"):     "XOF 1.00",
+	}
+	for money, want := range cases {
+		if got := money.String(); got != want {
+			t.Errorf("Expected %q, but got: %q", want, got)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	encoded, err := json.Marshal(TransferInput{Amount: NewMoney(5000, NGN)})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	var payload map[string]interface{}
+	json.Unmarshal(encoded, &payload)
+	if payload["amount"] != float64(5000) {
+		t.Errorf("Expected amount to be encoded as subunits, but got: %v", payload["amount"])
+	}
+
+	var transaction TransactionData
+	if err := json.Unmarshal([]byte(`{"amount":"25000","fees":375,"currency":"GHS"}`), &transaction); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if transaction.Amount != NewMoney(25000, GHS) || transaction.Fees != NewMoney(375, GHS) {
+		t.Errorf("Unexpected amounts: %v, %v", transaction.Amount, transaction.Fees)
+	}
+}
@@ -0,0 +1,72 @@
+package paystack
+
+import (
+	"net/http"
+
+	"github.com/berryboylb/go_paystack_wrapper/requests"
+)
+
+// Option configures a Paystack client.
+type Option func(*Paystack)
+
+// WithBaseURL points the client at a different API host, e.g. a local stand-in for tests.
+func WithBaseURL(url string) Option {
+	return func(p *Paystack) {
+		p.requestOptions = append(p.requestOptions, requests.WithBaseURL(url))
+	}
+}
+
+// WithHTTPClient sets the http.Client used for every call, e.g. one with timeouts or a proxy transport.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Paystack) {
+		p.requestOptions = append(p.requestOptions, requests.WithHTTPClient(client))
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every call.
+func WithUserAgent(userAgent string) Option {
+	return func(p *Paystack) {
+		p.requestOptions = append(p.requestOptions, requests.WithUserAgent(userAgent))
+	}
+}
+
+// WithHeaders adds default headers sent with every call.
+func WithHeaders(headers map[string]string) Option {
+	return func(p *Paystack) {
+		p.requestOptions = append(p.requestOptions, requests.WithHeaders(headers))
+	}
+}
+
+// newRequest builds a requests client carrying the options the Paystack client was created with.
+func (p *Paystack) newRequest() *requests.Request {
+	return requests.NewAPIClient(*p.APIKey, p.requestOptions...)
+}
+
+// WithRetryPolicy retries transient failures (network errors, 429 and 5xx) of
+// idempotent calls, see requests.RetryPolicy. Use requests.DefaultRetryPolicy for sane defaults.
+func WithRetryPolicy(policy requests.RetryPolicy) Option {
+	return func(p *Paystack) {
+		p.requestOptions = append(p.requestOptions, requests.WithRetryPolicy(policy))
+	}
+}
+
+// WithRateLimit limits the client to perSecond calls per second with bursts of up to burst calls.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return WithRateLimiter(requests.NewRateLimiter(perSecond, burst))
+}
+
+// WithSharedRateLimit is like WithRateLimit but shares the limiter with every
+// other client created with the same secret key in this process.
+func WithSharedRateLimit(perSecond float64, burst int) Option {
+	return func(p *Paystack) {
+		limiter := requests.SharedRateLimiter(*p.APIKey, perSecond, burst)
+		p.requestOptions = append(p.requestOptions, requests.WithRateLimiter(limiter))
+	}
+}
+
+// WithRateLimiter makes the client wait on limiter before every call, e.g. to share one limiter between clients.
+func WithRateLimiter(limiter *requests.RateLimiter) Option {
+	return func(p *Paystack) {
+		p.requestOptions = append(p.requestOptions, requests.WithRateLimiter(limiter))
+	}
+}
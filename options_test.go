@@ -0,0 +1,40 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/transaction/verify/ref_123" {
+			t.Errorf("Expected path '/transaction/verify/ref_123', but got: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer api-key" {
+			t.Errorf("Expected bearer authorization, but got: %s", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "wrapper-test" {
+			t.Errorf("Expected user agent 'wrapper-test', but got: %s", got)
+		}
+		if got := r.Header.Get("X-Trace"); got != "abc" {
+			t.Errorf("Expected default header 'X-Trace', but got: %s", got)
+		}
+		w.Write([]byte(`{"status":true,"message":"Verification successful","data":{"reference":"ref_123"}}`))
+	}))
+	defer server.Close()
+
+	p := NewPaystackClient("api-key",
+		WithBaseURL(server.URL),
+		WithHTTPClient(server.Client()),
+		WithUserAgent("wrapper-test"),
+		WithHeaders(map[string]string{"X-Trace": "abc"}),
+	)
+	resp, err := p.Verify("ref_123")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Reference != "ref_123" {
+		t.Errorf("Expected reference 'ref_123', but got: %s", resp.Data.Reference)
+	}
+}
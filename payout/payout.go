@@ -0,0 +1,270 @@
+// Package payout drives a transfer through Transfer, OTP confirmation and
+// verification as an explicit, resumable state machine:
+//
+//	created → pending_otp → processing → success, failed or reversed
+//
+// Every transition is saved to a Store before the next call to Paystack, so a
+// payout interrupted by a crash can be picked up again with Resume using the
+// transfer reference.
+package payout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+	"github.com/google/uuid"
+)
+
+// DefaultPollInterval is the time between verifications of a processing transfer.
+const DefaultPollInterval = 5 * time.Second
+
+var (
+	// ErrOTPRequired is returned when a transfer waits for an OTP and Payout.OTP is not set.
+	ErrOTPRequired = errors.New("payout: transfer requires an OTP but no OTP provider is set")
+	// ErrReferenceConflict is returned by Send when the reference is already
+	// stored for a payout with a different recipient, amount or reason.
+	ErrReferenceConflict = errors.New("payout: reference already used for a different payout")
+	// ErrInvalidTransition is returned when Paystack reports a status the payout cannot move to.
+	ErrInvalidTransition = errors.New("payout: invalid state transition")
+)
+
+// OTPProvider returns the OTP Paystack sent for the transfer of record, e.g. by
+// asking an operator for it.
+type OTPProvider func(ctx context.Context, record Record) (string, error)
+
+// Request describes a payout to an existing transfer recipient.
+type Request struct {
+	Reference string // generated when empty
+	Recipient string // recipient code
+	Amount    paystack.Money
+	Reason    string // required by Paystack
+}
+
+// Payout sends transfers and follows them until they settle.
+type Payout struct {
+	// OTP, when set, is asked for the OTP of transfers that require one.
+	// Without it such payouts stop in StatePendingOTP with ErrOTPRequired.
+	OTP OTPProvider
+	// Store persists payouts, a MemoryStore by default.
+	Store Store
+	// PollInterval is the time between verifications of a processing
+	// transfer, DefaultPollInterval when zero.
+	PollInterval time.Duration
+
+	client *paystack.Paystack
+}
+
+// New creates a Payout sending transfers with client.
+func New(client *paystack.Paystack) *Payout {
+	return &Payout{Store: NewMemoryStore(), client: client}
+}
+
+// Send records a payout and drives it until it reaches a final state, a step
+// fails or ctx is cancelled. It returns the payout as last saved together with
+// the error that stopped it, if any. Sending a reference that is already stored
+// resumes that payout instead of starting another one, provided the request
+// matches it.
+func (p *Payout) Send(ctx context.Context, req Request) (*Record, error) {
+	if req.Recipient == "" {
+		return nil, errors.New("payout: recipient is required")
+	}
+	if req.Amount.Amount <= 0 {
+		return nil, errors.New("payout: amount must be greater than zero")
+	}
+	if req.Reason == "" {
+		return nil, errors.New("payout: reason is required")
+	}
+	if req.Reference == "" {
+		req.Reference = uuid.New().String()
+	}
+
+	existing, err := p.Store.Load(ctx, req.Reference)
+	if err == nil {
+		if existing.Recipient != req.Recipient || existing.Amount != req.Amount || existing.Reason != req.Reason {
+			return nil, fmt.Errorf("%w: %s", ErrReferenceConflict, req.Reference)
+		}
+		return p.run(ctx, existing, true)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	record := &Record{
+		Reference: req.Reference,
+		Recipient: req.Recipient,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+		State:     StateCreated,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := p.Store.Save(ctx, record); err != nil {
+		return nil, err
+	}
+	return p.run(ctx, record, false)
+}
+
+// Resume continues the payout saved under reference. The transfer is verified
+// first, so a payout interrupted while its transfer was being sent is never
+// sent twice.
+func (p *Payout) Resume(ctx context.Context, reference string) (*Record, error) {
+	record, err := p.Store.Load(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	return p.run(ctx, record, true)
+}
+
+func (p *Payout) run(ctx context.Context, record *Record, resumed bool) (*Record, error) {
+	if resumed && !record.State.Final() {
+		if err := p.refresh(ctx, record); err != nil {
+			return record, err
+		}
+	}
+
+	for !record.State.Final() {
+		var err error
+		switch record.State {
+		case StateCreated:
+			err = p.initiate(ctx, record)
+		case StatePendingOTP:
+			err = p.confirm(ctx, record)
+		case StateProcessing:
+			err = p.poll(ctx, record)
+		default:
+			err = fmt.Errorf("payout: unknown state %q", record.State)
+		}
+		if err != nil {
+			return record, err
+		}
+	}
+	return record, nil
+}
+
+// refresh brings record up to date with the transfer Paystack knows under its reference.
+func (p *Payout) refresh(ctx context.Context, record *Record) error {
+	resp, err := p.client.VerifyTransferContext(ctx, record.Reference)
+	if err != nil {
+		if record.State == StateCreated && paystack.IsNotFound(err) {
+			// the transfer was never sent
+			return nil
+		}
+		return err
+	}
+	return p.advance(ctx, record, &resp.Data)
+}
+
+func (p *Payout) initiate(ctx context.Context, record *Record) error {
+	resp, err := p.client.TransferContext(ctx, paystack.TransferInput{
+		Amount:    record.Amount,
+		Recipient: record.Recipient,
+		Reason:    record.Reason,
+		Reference: record.Reference,
+	})
+	if err != nil {
+		if errors.Is(err, paystack.ErrReferenceConflict) {
+			// the reference belongs to another transfer, this one can never be sent under it
+			return p.fail(ctx, record, err)
+		}
+		if paystack.IsValidationError(err) {
+			return p.rejected(ctx, record, err)
+		}
+		// the transfer may or may not exist, Resume verifies before sending again
+		return err
+	}
+	return p.advance(ctx, record, &resp.Data)
+}
+
+// rejected handles a transfer Paystack refused with a validation error. The
+// refusal may be for a duplicate reference, e.g. after the store lost the
+// payout, so the reference is looked up before the payout is marked failed.
+func (p *Payout) rejected(ctx context.Context, record *Record, cause error) error {
+	resp, err := p.client.VerifyTransferContext(ctx, record.Reference)
+	switch {
+	case err == nil:
+		return p.advance(ctx, record, &resp.Data)
+	case paystack.IsNotFound(err):
+		// no transfer exists, e.g. the balance was insufficient
+		return p.fail(ctx, record, cause)
+	default:
+		return err
+	}
+}
+
+func (p *Payout) confirm(ctx context.Context, record *Record) error {
+	if p.OTP == nil {
+		return ErrOTPRequired
+	}
+	otp, err := p.OTP(ctx, *record)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.ConfirmTransferContext(ctx, paystack.ConfirmTransferInput{
+		TransferCode: record.TransferCode,
+		OTP:          otp,
+	})
+	if err != nil {
+		// e.g. a wrong OTP, the transfer keeps waiting for the right one
+		return err
+	}
+	return p.advance(ctx, record, &resp.Data)
+}
+
+func (p *Payout) poll(ctx context.Context, record *Record) error {
+	if err := p.refresh(ctx, record); err != nil {
+		return err
+	}
+	if record.State != StateProcessing {
+		return nil
+	}
+
+	interval := p.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// advance moves record to the state matching transfer and saves it.
+func (p *Payout) advance(ctx context.Context, record *Record, transfer *paystack.TransferData) error {
+	next, err := stateOf(transfer.Status)
+	if err != nil {
+		return err
+	}
+	if next == record.State && (transfer.TransferCode == "" || transfer.TransferCode == record.TransferCode) {
+		return nil
+	}
+	if next != record.State && !record.State.CanTransition(next) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, record.State, next)
+	}
+
+	if transfer.TransferCode != "" {
+		record.TransferCode = transfer.TransferCode
+	}
+	record.State = next
+	record.LastError = ""
+	record.UpdatedAt = time.Now()
+	return p.Store.Save(ctx, record)
+}
+
+// fail marks record failed with cause and saves it, returning cause.
+func (p *Payout) fail(ctx context.Context, record *Record, cause error) error {
+	record.State = StateFailed
+	record.LastError = cause.Error()
+	record.UpdatedAt = time.Now()
+	if err := p.Store.Save(ctx, record); err != nil {
+		return err
+	}
+	return cause
+}
@@ -0,0 +1,223 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+// fakePaystack answers transfer calls from routes, keyed by "METHOD path", and
+// counts the calls made to each.
+func fakePaystack(t *testing.T, routes map[string][]string) (*Payout, map[string]int) {
+	t.Helper()
+	calls := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		responses, ok := routes[key]
+		if !ok {
+			t.Errorf("Unexpected call: %s", key)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// repeat the last response once the others are used up
+		response := responses[len(responses)-1]
+		if calls[key] < len(responses) {
+			response = responses[calls[key]]
+		}
+		calls[key]++
+		if response == "404" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":false,"message":"Transfer not found"}`))
+			return
+		}
+		if response == "400" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"status":false,"message":"Your balance is not enough to fulfil this request"}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	p := New(paystack.NewPaystackClient("api-key", paystack.WithBaseURL(server.URL)))
+	p.PollInterval = time.Millisecond
+	return p, calls
+}
+
+func transfer(status string) string {
+	return `{"status":true,"message":"ok","data":{"reference":"payout_1","amount":50000,"currency":"NGN","status":"` + status + `","transfer_code":"TRF_1ptvuv321ahaa7q","recipient":1242}}`
+}
+
+var payoutRequest = Request{
+	Reference: "payout_1",
+	Recipient: "RCP_gx2wn530m0i3w3m",
+	Amount:    paystack.NewMoney(50000, paystack.NGN),
+	Reason:    "March salary",
+}
+
+func TestSendWithOTP(t *testing.T) {
+	p, calls := fakePaystack(t, map[string][]string{
+		"POST /transfer":                   {transfer("otp")},
+		"POST /transfer/finalize_transfer": {transfer("pending")},
+		"GET /transfer/verify/payout_1":    {transfer("pending"), transfer("success")},
+	})
+	p.OTP = func(ctx context.Context, record Record) (string, error) {
+		if record.State != StatePendingOTP || record.TransferCode != "TRF_1ptvuv321ahaa7q" {
+			t.Errorf("Unexpected record: %+v", record)
+		}
+		return "928783", nil
+	}
+
+	record, err := p.Send(context.Background(), payoutRequest)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if record.State != StateSuccess {
+		t.Errorf("Expected state %s, but got: %s", StateSuccess, record.State)
+	}
+	if calls["GET /transfer/verify/payout_1"] != 2 {
+		t.Errorf("Expected 2 verifications, but got: %d", calls["GET /transfer/verify/payout_1"])
+	}
+	saved, err := p.Store.Load(context.Background(), "payout_1")
+	if err != nil || saved.State != StateSuccess {
+		t.Errorf("Expected the final state to be saved, but got: %+v, %v", saved, err)
+	}
+}
+
+func TestResumeAfterOTP(t *testing.T) {
+	p, calls := fakePaystack(t, map[string][]string{
+		"POST /transfer":                   {transfer("otp")},
+		"GET /transfer/verify/payout_1":    {transfer("otp"), transfer("success")},
+		"POST /transfer/finalize_transfer": {transfer("pending")},
+	})
+
+	record, err := p.Send(context.Background(), payoutRequest)
+	if !errors.Is(err, ErrOTPRequired) || record.State != StatePendingOTP {
+		t.Fatalf("Expected to stop for an OTP, but got: %s, %v", record.State, err)
+	}
+
+	p.OTP = func(ctx context.Context, record Record) (string, error) { return "928783", nil }
+	record, err = p.Resume(context.Background(), "payout_1")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if record.State != StateSuccess || calls["POST /transfer"] != 1 {
+		t.Errorf("Expected a single successful transfer, but got: %s after %d transfers", record.State, calls["POST /transfer"])
+	}
+}
+
+func TestResumeDoesNotResend(t *testing.T) {
+	p, calls := fakePaystack(t, map[string][]string{
+		"GET /transfer/verify/payout_1": {transfer("success")},
+	})
+	// crashed after saving the payout but before hearing back from Paystack
+	p.Store.Save(context.Background(), &Record{Reference: "payout_1", Recipient: payoutRequest.Recipient, Amount: payoutRequest.Amount, Reason: payoutRequest.Reason, State: StateCreated})
+
+	record, err := p.Send(context.Background(), payoutRequest)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if record.State != StateSuccess || calls["POST /transfer"] != 0 {
+		t.Errorf("Expected the existing transfer to be adopted, but got: %s after %d transfers", record.State, calls["POST /transfer"])
+	}
+}
+
+func TestSendReferenceConflict(t *testing.T) {
+	p := New(paystack.NewPaystackClient("api-key"))
+	p.Store.Save(context.Background(), &Record{Reference: "payout_1", Recipient: payoutRequest.Recipient, Amount: payoutRequest.Amount, Reason: payoutRequest.Reason, State: StateSuccess})
+
+	req := payoutRequest
+	req.Amount = paystack.NewMoney(90000, paystack.NGN)
+	if _, err := p.Send(context.Background(), req); !errors.Is(err, ErrReferenceConflict) {
+		t.Errorf("Expected ErrReferenceConflict, but got: %v", err)
+	}
+	record, err := p.Send(context.Background(), payoutRequest)
+	if err != nil || record.State != StateSuccess {
+		t.Errorf("Expected the stored payout for a matching request, but got: %+v, %v", record, err)
+	}
+}
+
+func TestResumeSendsUnsentTransfer(t *testing.T) {
+	p, _ := fakePaystack(t, map[string][]string{
+		"GET /transfer/verify/payout_1": {"404"},
+		"POST /transfer":                {transfer("success")},
+	})
+	p.Store.Save(context.Background(), &Record{Reference: "payout_1", Recipient: payoutRequest.Recipient, Amount: payoutRequest.Amount, Reason: payoutRequest.Reason, State: StateCreated})
+
+	record, err := p.Resume(context.Background(), "payout_1")
+	if err != nil || record.State != StateSuccess {
+		t.Errorf("Expected the transfer to be sent, but got: %s, %v", record.State, err)
+	}
+}
+
+func TestSendRejected(t *testing.T) {
+	p, _ := fakePaystack(t, map[string][]string{
+		"POST /transfer":                {"400"},
+		"GET /transfer/verify/payout_1": {"404"},
+	})
+
+	record, err := p.Send(context.Background(), payoutRequest)
+	if !paystack.IsValidationError(err) {
+		t.Errorf("Expected a validation error, but got: %v", err)
+	}
+	if record.State != StateFailed || record.LastError == "" {
+		t.Errorf("Expected a failed payout, but got: %+v", record)
+	}
+}
+
+func TestSendAdoptsDuplicateReference(t *testing.T) {
+	// the store lost the payout, so Paystack refuses the reference it already has
+	p, _ := fakePaystack(t, map[string][]string{
+		"POST /transfer":                {"400"},
+		"GET /transfer/verify/payout_1": {transfer("success")},
+	})
+
+	record, err := p.Send(context.Background(), payoutRequest)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if record.State != StateSuccess || record.TransferCode != "TRF_1ptvuv321ahaa7q" {
+		t.Errorf("Expected the existing transfer to be adopted, but got: %+v", record)
+	}
+}
+
+func TestResumeUnknown(t *testing.T) {
+	p := New(paystack.NewPaystackClient("api-key"))
+	if _, err := p.Resume(context.Background(), "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, but got: %v", err)
+	}
+}
+
+func TestStateTransitions(t *testing.T) {
+	tests := []struct {
+		from, to State
+		want     bool
+	}{
+		{StateCreated, StatePendingOTP, true},
+		{StatePendingOTP, StateProcessing, true},
+		{StateProcessing, StateSuccess, true},
+		{StateProcessing, StatePendingOTP, false},
+		{StateSuccess, StateReversed, false},
+		{StateFailed, StateProcessing, false},
+	}
+	for _, test := range tests {
+		if got := test.from.CanTransition(test.to); got != test.want {
+			t.Errorf("%s.CanTransition(%s) = %v, want %v", test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestMemoryStoreZeroValue(t *testing.T) {
+	var store MemoryStore
+	if err := store.Save(context.Background(), &Record{Reference: "payout_1"}); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if _, err := store.Load(context.Background(), "payout_1"); err != nil {
+		t.Errorf("Expected the saved record, but got: %v", err)
+	}
+}
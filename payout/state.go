@@ -0,0 +1,62 @@
+package payout
+
+import (
+	"fmt"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+// State is the stage a payout is in.
+type State string
+
+const (
+	// StateCreated is a payout that was recorded but possibly not yet sent to Paystack.
+	StateCreated State = "created"
+	// StatePendingOTP is a transfer waiting to be confirmed with an OTP.
+	StatePendingOTP State = "pending_otp"
+	// StateProcessing is a transfer Paystack accepted but has not settled yet.
+	StateProcessing State = "processing"
+	StateSuccess    State = "success"
+	StateFailed     State = "failed"
+	StateReversed   State = "reversed"
+)
+
+// transitions lists the states each state may move to.
+var transitions = map[State][]State{
+	StateCreated:    {StatePendingOTP, StateProcessing, StateSuccess, StateFailed, StateReversed},
+	StatePendingOTP: {StateProcessing, StateSuccess, StateFailed, StateReversed},
+	StateProcessing: {StateSuccess, StateFailed, StateReversed},
+}
+
+// Final reports whether no further transition is possible from s.
+func (s State) Final() bool {
+	return s == StateSuccess || s == StateFailed || s == StateReversed
+}
+
+// CanTransition reports whether a payout in s may move to next.
+func (s State) CanTransition(next State) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// stateOf maps the status of a transfer onto the payout state machine.
+func stateOf(status paystack.TransferStatus) (State, error) {
+	switch status {
+	case paystack.TransferOTP:
+		return StatePendingOTP, nil
+	case paystack.TransferPending, paystack.TransferReceived:
+		return StateProcessing, nil
+	case paystack.TransferSuccess:
+		return StateSuccess, nil
+	case paystack.TransferFailed:
+		return StateFailed, nil
+	case paystack.TransferReversed:
+		return StateReversed, nil
+	default:
+		return "", fmt.Errorf("payout: unknown transfer status %q", status)
+	}
+}
@@ -0,0 +1,74 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+// ErrNotFound is returned by Store.Load for references never saved.
+var ErrNotFound = errors.New("payout: payout not found")
+
+// Record is what a Store keeps about one payout. The reference is the
+// transfer reference and identifies the payout across restarts.
+type Record struct {
+	Reference    string
+	Recipient    string // recipient code
+	Amount       paystack.Money
+	Reason       string
+	TransferCode string
+	State        State
+	LastError    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Store persists payouts so that they can be resumed after a crash.
+//
+// Save is called before every call to Paystack that may move a payout forward,
+// so in SQL it maps to an upsert on a table keyed by reference.
+type Store interface {
+	// Save creates or replaces the record with the same reference.
+	Save(ctx context.Context, record *Record) error
+	// Load returns the record for reference or ErrNotFound.
+	Load(ctx context.Context, reference string) (*Record, error)
+}
+
+// MemoryStore is an in-memory Store, suitable for tests and for payouts that
+// need not survive a restart. The zero value is ready to use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(ctx context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.records == nil {
+		s.records = make(map[string]Record)
+	}
+	s.records[record.Reference] = *record
+	return nil
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load(ctx context.Context, reference string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[reference]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &record, nil
+}
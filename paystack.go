@@ -1,18 +1,20 @@
 package paystack
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"github.com/berryboylb/go_paystack_wrapper/requests"
-	"github.com/google/uuid"
-	"io"
 	"net/http"
+	"net/url"
 	"regexp"
 )
 
-func NewPaystackClient(apiKey string) *Paystack {
-	return &Paystack{APIKey: &apiKey}
+func NewPaystackClient(apiKey string, opts ...Option) *Paystack {
+	p := &Paystack{APIKey: &apiKey}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 func isValidEmail(email string) bool {
@@ -22,13 +24,44 @@ func isValidEmail(email string) bool {
 	return match
 }
 
+// Initialize starts a transaction. payload is either an InitializeInput (or a
+// pointer to one) or, for backward compatibility, a map with at least the
+// 'email' and 'amount' keys.
 func (p *Paystack) Initialize(payload interface{}) (*PostResponseData, error) {
-	// Assert that the payload is a map with string keys
-	payloadMap, ok := payload.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("expected payload to be a map with string keys, got %T", payload)
+	return p.InitializeContext(context.Background(), payload)
+}
+
+// InitializeContext is like Initialize but aborts the call when ctx is cancelled.
+func (p *Paystack) InitializeContext(ctx context.Context, payload interface{}) (*PostResponseData, error) {
+	switch input := payload.(type) {
+	case InitializeInput:
+		return p.initialize(ctx, input)
+	case *InitializeInput:
+		if input == nil {
+			return nil, errors.New("payload must not be nil")
+		}
+		return p.initialize(ctx, *input)
+	case map[string]interface{}:
+		return p.initializeMap(ctx, input)
+	default:
+		return nil, fmt.Errorf("expected payload to be an InitializeInput or a map with string keys, got %T", payload)
 	}
+}
 
+func (p *Paystack) initialize(ctx context.Context, input InitializeInput) (*PostResponseData, error) {
+	//validate arguments
+	err := Validate(input)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if input.Currency, err = resolveCurrency(input.Currency, input.Amount); err != nil {
+		return nil, err
+	}
+	return do[PostResponseData](ctx, p, "transaction.initialize", http.MethodPost, "/transaction/initialize", nil, input)
+}
+
+// initializeMap is the original map based Initialize, kept for existing callers.
+func (p *Paystack) initializeMap(ctx context.Context, payloadMap map[string]interface{}) (*PostResponseData, error) {
 	// Validate and extract 'email' field
 	email, emailExists := payloadMap["email"].(string)
 	if !emailExists {
@@ -39,191 +72,79 @@ func (p *Paystack) Initialize(payload interface{}) (*PostResponseData, error) {
 	}
 
 	// Validate and extract 'amount' field
-	amount, amountExists := payloadMap["amount"].(float64)
+	amount, amountExists := toFloat(payloadMap["amount"])
 	if !amountExists {
-		return nil, errors.New("payload must contain 'amount' field of type float64")
+		return nil, fmt.Errorf("payload must contain a numeric 'amount' field, got %T", payloadMap["amount"])
 	}
 	if amount <= 0 {
 		return nil, errors.New("amount must be greater than zero")
 	}
 
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Post("/transaction/initialize", payload)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	//read data from the body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
-
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
-		}
-		return nil, errors.New("received non-200 response" + failed.Message)
-	}
-
-	// convert to JSON object and return it
-	var responseData PostResponseData
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
-	}
-	//check if response.status is not okay
-	if !responseData.Status {
-		return nil, errors.New("failed to initialize transaction " + responseData.Message)
-	}
-
-	//return data
-	return &responseData, nil
+	return do[PostResponseData](ctx, p, "transaction.initialize", http.MethodPost, "/transaction/initialize", nil, payloadMap)
 }
 
 func (p *Paystack) Verify(reference string) (*GetResponseData, error) {
-	//initialize new request
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Get("/transaction/verify/" + reference)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
-
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
-		}
-		return nil, errors.New("received non-200 response" + failed.Message)
-	}
+	return p.VerifyContext(context.Background(), reference)
+}
 
-	// convert to JSON object and return it
-	var responseData GetResponseData
-	err = json.Unmarshal(body, &responseData)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
-	}
-	//return data
-	return &responseData, nil
+// VerifyContext is like Verify but aborts the call when ctx is cancelled.
+func (p *Paystack) VerifyContext(ctx context.Context, reference string) (*GetResponseData, error) {
+	return do[GetResponseData](ctx, p, "transaction.verify", http.MethodGet, "/transaction/verify/"+url.PathEscape(reference), nil, nil)
 }
 
 func (p *Paystack) ListTransactions(filter ListTransactions) (*FullResponse, error) {
+	return p.ListTransactionsContext(context.Background(), filter)
+}
+
+// ListTransactionsContext is like ListTransactions but aborts the call when ctx is cancelled.
+func (p *Paystack) ListTransactionsContext(ctx context.Context, filter ListTransactions) (*FullResponse, error) {
 	//validate arguments
 	err := Validate(filter)
 	if err != nil {
 		return nil, errors.New("Error validating  arguments: " + err.Error())
 	}
-	// filter fields that are empty
-	filtered := filter.FilterFields()
-
-	//encode values as params
-	encodedParams, err := encodeFilteredFields(filtered)
+	//encode non-empty values as params
+	query, err := encodeQuery(filter)
 	if err != nil {
 		return nil, errors.New("Error encoding filtered data: " + err.Error())
 	}
-
-	// Construct the full URL with query parameters
-	fullURL := "/transaction" + "?" + encodedParams
-
-	//initialize new request
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Get(fullURL)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
-
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
-		}
-		return nil, errors.New("received non-200 response" + failed.Message)
-	}
-
-	// convert to JSON object and return it
-	var response FullResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
-	}
-	//return data
-	return &response, nil
+	return do[FullResponse](ctx, p, "transaction.list", http.MethodGet, "/transaction", query, nil)
 }
 
 func (p *Paystack) ListBanks(filter FilterBanks) (*BankResponse, error) {
+	return p.ListBanksContext(context.Background(), filter)
+}
+
+// ListBanksContext is like ListBanks but aborts the call when ctx is cancelled.
+func (p *Paystack) ListBanksContext(ctx context.Context, filter FilterBanks) (*BankResponse, error) {
 	//validate arguments
 	err := Validate(filter)
 	if err != nil {
 		return nil, errors.New("Error validating  arguments: " + err.Error())
 	}
-	// filter fields that are empty
-	filtered := filter.FilterEmptyFields()
-
-	//encode values as params
-	encodedParams, err := encodeFilteredFields(filtered)
+	//encode non-empty values as params
+	query, err := encodeQuery(filter)
 	if err != nil {
 		return nil, errors.New("Error encoding filtered data: " + err.Error())
 	}
-
-	// Construct the full URL with query parameters
-	fullURL := "/bank" + "?" + encodedParams
-
-	//initialize new request
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Get(fullURL)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
-		}
-		return nil, errors.New("received non-200 response" + failed.Message)
-	}
-
-	// convert to JSON object and return it
-	var response BankResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
-	}
-	//return data
-	return &response, nil
+	return do[BankResponse](ctx, p, "bank.list", http.MethodGet, "/bank", query, nil)
 }
 
+// Transfer sends money from the balance to a recipient. Either a Reference or
+// an IdempotencyKey is required so that the transfer can be identified when
+// the outcome of the call is unclear.
 func (p *Paystack) Transfer(payload TransferInput) (*InitTransferResponse, error) {
+	return p.TransferContext(context.Background(), payload)
+}
+
+// TransferContext is like Transfer but aborts the call when ctx is cancelled.
+//
+// When the call fails without a clear answer (network errors, timeouts, 5xx)
+// the transfer is looked up by its reference: an existing transfer is returned
+// as if the call had succeeded, unless it differs from payload in which case
+// the error wraps ErrReferenceConflict, and a missing one is sent again once
+// after a short wait. If the lookup fails as well the error wraps ErrOutcomeUnknown.
+func (p *Paystack) TransferContext(ctx context.Context, payload TransferInput) (*InitTransferResponse, error) {
 	//validate arguments
 	err := Validate(payload)
 	if err != nil {
@@ -231,11 +152,14 @@ func (p *Paystack) Transfer(payload TransferInput) (*InitTransferResponse, error
 	}
 
 	// Set default values for optional fields
+	if payload.Currency, err = resolveCurrency(payload.Currency, payload.Amount); err != nil {
+		return nil, err
+	}
 	if payload.Currency == "" {
-		payload.Currency = "NGN"
+		payload.Currency = string(NGN)
 	}
 	if payload.Reference == "" {
-		payload.Reference = uuid.New().String()
+		payload.Reference = TransferReference(payload.IdempotencyKey)
 	}
 
 	//build payload
@@ -248,41 +172,40 @@ func (p *Paystack) Transfer(payload TransferInput) (*InitTransferResponse, error
 		"reference": payload.Reference,
 	}
 
-	//initialize new request
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Post("/transfer", requestBody)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
+	for attempt := 1; ; attempt++ {
+		response, err := do[InitTransferResponse](ctx, p, "transfer.initiate", http.MethodPost, "/transfer", nil, requestBody)
+		if err == nil || !isAmbiguous(err) {
+			return response, err
+		}
 
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
+		// the transfer may have been created, look it up before sending it again
+		existing, verifyErr := p.lookupTransfer(ctx, payload.Reference)
+		switch {
+		case verifyErr == nil:
+			if !matchesTransfer(existing.Data, payload) {
+				return nil, fmt.Errorf("%w: %s", ErrReferenceConflict, payload.Reference)
+			}
+			return &InitTransferResponse{Status: existing.Status, Message: existing.Message, Data: existing.Data}, nil
+		case !IsNotFound(verifyErr):
+			return nil, fmt.Errorf("%w (reference %s): %w", ErrOutcomeUnknown, payload.Reference, err)
+		case attempt >= transferAttempts:
+			// no transfer was created, sending the same reference again is safe
+			return nil, err
 		}
-		return nil, errors.New("received non-200 response" + failed.Message)
-	}
 
-	// convert to JSON object and return it
-	var response InitTransferResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
+		// give Paystack a moment to recover before sending the transfer again
+		if waitErr := wait(ctx, transferBackoff<<(attempt-1)); waitErr != nil {
+			return nil, err
+		}
 	}
-	//return data
-	return &response, nil
 }
 
 func (p *Paystack) ConfirmTransfer(payload ConfirmTransferInput) (*ConfirmTransferResponse, error) {
+	return p.ConfirmTransferContext(context.Background(), payload)
+}
+
+// ConfirmTransferContext is like ConfirmTransfer but aborts the call when ctx is cancelled.
+func (p *Paystack) ConfirmTransferContext(ctx context.Context, payload ConfirmTransferInput) (*ConfirmTransferResponse, error) {
 	//validate arguments
 	err := Validate(payload)
 	if err != nil {
@@ -292,75 +215,19 @@ func (p *Paystack) ConfirmTransfer(payload ConfirmTransferInput) (*ConfirmTransf
 		"transfer_code": payload.TransferCode, //only balance is allowed for now
 		"otp":           payload.OTP,
 	}
-	//initialize new request
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Post("/transfer/finalize_transfer", requestBody)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
-
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
-		}
-		return nil, errors.New("received non-200 response " + failed.Message)
-	}
-
-	// convert to JSON object and return it
-	var response ConfirmTransferResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
-	}
-	//return data
-	return &response, nil
+	return do[ConfirmTransferResponse](ctx, p, "transfer.finalize", http.MethodPost, "/transfer/finalize_transfer", nil, requestBody)
 }
 
 func (p *Paystack) CreateRecipient(payload AccountDetails) (*Recipient, error) {
+	return p.CreateRecipientContext(context.Background(), payload)
+}
+
+// CreateRecipientContext is like CreateRecipient but aborts the call when ctx is cancelled.
+func (p *Paystack) CreateRecipientContext(ctx context.Context, payload AccountDetails) (*Recipient, error) {
 	//validate arguments
 	err := Validate(payload)
 	if err != nil {
 		return nil, errors.New("Error validating  arguments: " + err.Error())
 	}
-	//initialize new request
-	paystackClient := requests.NewAPIClient(*p.APIKey)
-	resp, err := paystackClient.Post("/transferrecipient", payload)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// read the response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.New("Error reading response body: " + err.Error())
-	}
-	//check for response
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		var failed Error
-		err = json.Unmarshal(body, &failed)
-		if err != nil {
-			return nil, errors.New("Error decoding failed JSON: " + err.Error())
-		}
-		return nil, errors.New("received non-200 response" + failed.Message)
-	}
-
-	// convert to JSON object and return it
-	var response Recipient
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, errors.New("Error decoding JSON: " + err.Error())
-	}
-	//return data
-	return &response, nil
+	return do[Recipient](ctx, p, "transferrecipient.create", http.MethodPost, "/transferrecipient", nil, payload)
 }
@@ -33,7 +33,7 @@ var mockVerifyResponse = GetResponseData{
 		ID:              3516052615,
 		Domain:          "test",
 		Reference:       "0l2qk643pk",
-		Amount:          2000000,
+		Amount:          NewMoney(2000000, NGN),
 		GatewayResponse: "Successful",
 		PaidAt:          time.Date(2024, 2, 3, 0, 53, 26, 0, time.UTC),
 		CreatedAt:       time.Date(2024, 2, 3, 0, 53, 3, 0, time.UTC),
@@ -51,7 +51,7 @@ var mockVerifyResponse = GetResponseData{
 			Input:     []string{},
 			History:   []History{{Type: "action", Message: "Attempted to pay with card", Time: 3}, {Type: "success", Message: "Successfully paid with card", Time: 4}},
 		},
-		Fees:      40000,
+		Fees:      NewMoney(40000, NGN),
 		FeesSplit: nil, // Null value
 		Authorization: AuthorizationData{
 			AuthorizationCode: "AUTH_c1u2j76bg5",
@@ -79,18 +79,18 @@ var mockVerifyResponse = GetResponseData{
 			RiskAction:               "default",
 			InternationalFormatPhone: nil, // Null value
 		},
-		Plan:               nil, // Null value
-		Split:              map[string]interface{}{},
+		Plan:               TransactionPlan{}, // Null value
+		Split:              Split{},
 		OrderID:            nil, // Null value
 		PaidAtISO:          "2024-02-03T00:53:26.000Z",
 		CreatedAtISO:       "2024-02-03T00:53:03.000Z",
-		RequestedAmount:    2000000,
+		RequestedAmount:    NewMoney(2000000, NGN),
 		POSTransactionData: nil, // Null value
 		Source:             nil, // Null value
 		FeesBreakdown:      nil, // Null value
 		TransactionDate:    "2024-02-03T00:53:03.000Z",
 		PlanObject:         map[string]interface{}{},
-		Subaccount:         map[string]interface{}{},
+		Subaccount:         Subaccount{},
 	},
 }
 
@@ -242,9 +242,10 @@ func TestCreateRecipient(t *testing.T) {
 func TestTransfer(t *testing.T) {
 	p := NewPaystackClient("api-key")
 	resp, err := p.Transfer(TransferInput{
-		Amount:    50 * 100,
+		Amount:    NewMoney(50*100, NGN),
 		Recipient: "RCP_c8y67uhuvl2xmws",
 		Reason:    "test",
+		Reference: "test_transfer_reference",
 	})
 	if err != nil {
 		t.Errorf("Expected no error, but got: %v", err)
@@ -277,3 +278,16 @@ func TestConfirmTransfer(t *testing.T) {
 	}
 	fmt.Println(resp)
 }
+
+func TestListBanksQuery(t *testing.T) {
+	p, last := stubClient(t, "GET", "/bank", `{"status":true,"message":"Banks retrieved","data":[]}`, nil)
+	payWithBank := false
+	_, err := p.ListBanks(FilterBanks{Country: "nigeria", UseCursor: "true", PerPage: 50, PayWithBank: &payWithBank})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	query := last.URL.Query()
+	if query.Get("country") != "nigeria" || query.Get("perPage") != "50" || query.Get("pay_with_bank") != "false" || query.Has("gateway") {
+		t.Errorf("Unexpected query: %s", last.URL.RawQuery)
+	}
+}
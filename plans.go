@@ -0,0 +1,196 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// PlanInterval is how often subscribers to a plan are charged.
+type PlanInterval string
+
+const (
+	IntervalDaily      PlanInterval = "daily"
+	IntervalWeekly     PlanInterval = "weekly"
+	IntervalMonthly    PlanInterval = "monthly"
+	IntervalQuarterly  PlanInterval = "quarterly"  // every 3 months
+	IntervalBiannually PlanInterval = "biannually" // every 6 months
+	IntervalAnnually   PlanInterval = "annually"
+)
+
+// create plan
+type PlanInput struct {
+	Name         string       `json:"name" validate:"required"`
+	Amount       Money        `json:"amount" validate:"required,gt=0"`
+	Interval     PlanInterval `json:"interval" validate:"required,oneof=daily weekly monthly quarterly biannually annually"`
+	Description  string       `json:"description,omitempty"`
+	SendInvoices *bool        `json:"send_invoices,omitempty"`
+	SendSMS      *bool        `json:"send_sms,omitempty"`
+	Currency     string       `json:"currency,omitempty" validate:"omitempty,len=3"`
+	InvoiceLimit int          `json:"invoice_limit,omitempty" validate:"gte=0"` // number of charges per subscription, 0 for no limit
+}
+
+// update plan, empty fields are left unchanged
+type UpdatePlanInput struct {
+	Name         string       `json:"name,omitempty"`
+	Amount       *Money       `json:"amount,omitempty"`
+	Interval     PlanInterval `json:"interval,omitempty" validate:"omitempty,oneof=daily weekly monthly quarterly biannually annually"`
+	Description  string       `json:"description,omitempty"`
+	SendInvoices *bool        `json:"send_invoices,omitempty"`
+	SendSMS      *bool        `json:"send_sms,omitempty"`
+	Currency     string       `json:"currency,omitempty" validate:"omitempty,len=3"`
+	InvoiceLimit *int         `json:"invoice_limit,omitempty" validate:"omitempty,gte=0"`
+	// UpdateExistingSubscriptions applies the new amount and interval to current subscribers as well.
+	UpdateExistingSubscriptions *bool `json:"update_existing_subscriptions,omitempty"`
+}
+
+// list plans
+type ListPlans struct {
+	PageFilter
+	Status   string       `json:"status" schema:"status"`
+	Interval PlanInterval `json:"interval" schema:"interval"`
+	Amount   Money        `json:"amount" schema:"amount"`
+}
+
+type Plan struct {
+	ID                int64          `json:"id"`
+	Name              string         `json:"name"`
+	PlanCode          string         `json:"plan_code"`
+	Description       *string        `json:"description"` // Use a pointer to allow for null values
+	Amount            Money          `json:"amount"`
+	Interval          PlanInterval   `json:"interval"`
+	Currency          string         `json:"currency"`
+	Integration       int64          `json:"integration"`
+	Domain            string         `json:"domain"`
+	SendInvoices      bool           `json:"send_invoices"`
+	SendSMS           bool           `json:"send_sms"`
+	HostedPage        bool           `json:"hosted_page"`
+	HostedPageURL     *string        `json:"hosted_page_url"`     // Use a pointer to allow for null values
+	HostedPageSummary *string        `json:"hosted_page_summary"` // Use a pointer to allow for null values
+	InvoiceLimit      int            `json:"invoice_limit"`
+	Subscriptions     []Subscription `json:"subscriptions"`
+	CreatedAt         string         `json:"createdAt"`
+	UpdatedAt         string         `json:"updatedAt"`
+}
+
+type PlanResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    Plan   `json:"data"`
+}
+
+type PlanListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Plan          `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+// TransactionPlan is the plan a transaction paid for. Paystack sends an empty
+// object or null when there is none, and otherwise the plan code, its ID or
+// the whole plan object.
+type TransactionPlan struct {
+	Plan
+}
+
+// UnmarshalJSON accepts null, an empty object, a plan code, a plan ID or a plan object.
+func (pl *TransactionPlan) UnmarshalJSON(data []byte) error {
+	*pl = TransactionPlan{}
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var code string
+		if err := json.Unmarshal(data, &code); err != nil {
+			return err
+		}
+		if id, err := strconv.ParseInt(code, 10, 64); err == nil {
+			pl.ID = id
+			return nil
+		}
+		pl.PlanCode = code
+		return nil
+	}
+	return unmarshalIDOrObject(data, &pl.ID, &pl.Plan)
+}
+
+// UnmarshalJSON fills in the currency of Amount from the plan's currency.
+func (pl *Plan) UnmarshalJSON(data []byte) error {
+	type alias Plan
+	if err := json.Unmarshal(data, (*alias)(pl)); err != nil {
+		return err
+	}
+	pl.Amount.Currency = Currency(pl.Currency)
+	return nil
+}
+
+func (p *Paystack) CreatePlan(payload PlanInput) (*PlanResponse, error) {
+	return p.CreatePlanContext(context.Background(), payload)
+}
+
+// CreatePlanContext is like CreatePlan but aborts the call when ctx is cancelled.
+func (p *Paystack) CreatePlanContext(ctx context.Context, payload PlanInput) (*PlanResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.Currency, err = resolveCurrency(payload.Currency, payload.Amount); err != nil {
+		return nil, err
+	}
+	return do[PlanResponse](ctx, p, "plan.create", http.MethodPost, "/plan", nil, payload)
+}
+
+func (p *Paystack) ListPlans(filter ListPlans) (*PlanListResponse, error) {
+	return p.ListPlansContext(context.Background(), filter)
+}
+
+// ListPlansContext is like ListPlans but aborts the call when ctx is cancelled.
+func (p *Paystack) ListPlansContext(ctx context.Context, filter ListPlans) (*PlanListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[PlanListResponse](ctx, p, "plan.list", http.MethodGet, "/plan", query, nil)
+}
+
+// FetchPlan returns a plan by ID or plan code.
+func (p *Paystack) FetchPlan(idOrCode string) (*PlanResponse, error) {
+	return p.FetchPlanContext(context.Background(), idOrCode)
+}
+
+// FetchPlanContext is like FetchPlan but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchPlanContext(ctx context.Context, idOrCode string) (*PlanResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("plan id or code is required")
+	}
+	return do[PlanResponse](ctx, p, "plan.fetch", http.MethodGet, "/plan/"+url.PathEscape(idOrCode), nil, nil)
+}
+
+func (p *Paystack) UpdatePlan(idOrCode string, payload UpdatePlanInput) (*MessageResponse, error) {
+	return p.UpdatePlanContext(context.Background(), idOrCode, payload)
+}
+
+// UpdatePlanContext is like UpdatePlan but aborts the call when ctx is cancelled.
+func (p *Paystack) UpdatePlanContext(ctx context.Context, idOrCode string, payload UpdatePlanInput) (*MessageResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("plan id or code is required")
+	}
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.Amount != nil {
+		if payload.Currency, err = resolveCurrency(payload.Currency, *payload.Amount); err != nil {
+			return nil, err
+		}
+	}
+	return do[MessageResponse](ctx, p, "plan.update", http.MethodPut, "/plan/"+url.PathEscape(idOrCode), nil, payload)
+}
@@ -0,0 +1,82 @@
+package paystack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCreatePlan(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/plan",
+		`{"status":true,"message":"Plan created","data":{"name":"Monthly membership","interval":"monthly","amount":500000,"integration":428626,"domain":"test","currency":"NGN","plan_code":"PLN_u4cqud8vabi89bl","send_invoices":true,"send_sms":true,"hosted_page":false,"id":1716,"createdAt":"2024-05-22T10:12:46.000Z","updatedAt":"2024-05-22T10:12:46.000Z"}}`,
+		&received)
+
+	resp, err := p.CreatePlan(PlanInput{Name: "Monthly membership", Amount: MajorUnits(5000, NGN), Interval: IntervalMonthly})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["amount"] != float64(500000) || received["interval"] != "monthly" || received["currency"] != "NGN" {
+		t.Errorf("Unexpected body: %v", received)
+	}
+	if resp.Data.PlanCode != "PLN_u4cqud8vabi89bl" || resp.Data.Amount != NewMoney(500000, NGN) {
+		t.Errorf("Unexpected plan: %+v", resp.Data)
+	}
+}
+
+func TestCreatePlanInvalidInterval(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	if _, err := p.CreatePlan(PlanInput{Name: "Hourly", Amount: NewMoney(1000, NGN), Interval: "hourly"}); err == nil {
+		t.Fatal("Expected an error for an unsupported interval")
+	}
+}
+
+func TestListPlans(t *testing.T) {
+	p, last := stubClient(t, "GET", "/plan",
+		`{"status":true,"message":"Plans retrieved","data":[{"subscriptions":[{"customer":63,"plan":1716,"status":"active","amount":500000,"subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","id":1}],"name":"Monthly membership","plan_code":"PLN_u4cqud8vabi89bl","amount":500000,"interval":"monthly","currency":"NGN","id":1716}],"meta":{"total":1,"skipped":0,"perPage":50,"page":1,"pageCount":1}}`,
+		nil)
+
+	resp, err := p.ListPlans(ListPlans{Interval: IntervalMonthly})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if last.URL.Query().Get("interval") != "monthly" {
+		t.Errorf("Unexpected query: %s", last.URL.RawQuery)
+	}
+	subscription := resp.Data[0].Subscriptions[0]
+	if subscription.Customer.ID != 63 || subscription.Plan.ID != 1716 || subscription.Status != SubscriptionActive {
+		t.Errorf("Unexpected subscription: %+v", subscription)
+	}
+}
+
+func TestUpdatePlan(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "PUT", "/plan/PLN_u4cqud8vabi89bl", `{"status":true,"message":"Plan updated. 1 subscription(s) affected"}`, &received)
+
+	amount := MajorUnits(6000, NGN)
+	if _, err := p.UpdatePlan("PLN_u4cqud8vabi89bl", UpdatePlanInput{Amount: &amount}); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(received) != 2 || received["amount"] != float64(600000) || received["currency"] != "NGN" {
+		t.Errorf("Expected only amount and currency to be sent, but got: %v", received)
+	}
+}
+
+func TestTransactionPlanDecoding(t *testing.T) {
+	cases := map[string]TransactionPlan{
+		`null`:                  {},
+		`{}`:                    {},
+		`"PLN_gx2wn530m0i3w3m"`: {Plan{PlanCode: "PLN_gx2wn530m0i3w3m"}},
+		`1716`:                  {Plan{ID: 1716}},
+		`{"id":1716,"plan_code":"PLN_gx2wn530m0i3w3m","amount":500000,"currency":"NGN"}`: {Plan{ID: 1716, PlanCode: "PLN_gx2wn530m0i3w3m", Amount: NewMoney(500000, NGN), Currency: "NGN"}},
+	}
+	for input, want := range cases {
+		var transaction TransactionData
+		if err := json.Unmarshal([]byte(`{"plan":`+input+`}`), &transaction); err != nil {
+			t.Fatalf("Expected %s to decode, but got: %v", input, err)
+		}
+		got := transaction.Plan
+		if got.ID != want.ID || got.PlanCode != want.PlanCode || got.Amount != want.Amount {
+			t.Errorf("Decoding %s: expected %+v, but got: %+v", input, want, got)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var nubanPattern = regexp.MustCompile(`^[0-9]{10}$`)
+
+// validateRecipient applies the account number rules of each recipient type.
+func validateRecipient(sl validator.StructLevel) {
+	details := sl.Current().Interface().(AccountDetails)
+	switch details.Type {
+	case RecipientNUBAN:
+		if details.AccountNumber != "" && !nubanPattern.MatchString(details.AccountNumber) {
+			sl.ReportError(details.AccountNumber, "AccountNumber", "AccountNumber", "nuban", "")
+		}
+	case RecipientAuthorization:
+		if details.AuthorizationCode != "" && !strings.HasPrefix(details.AuthorizationCode, "AUTH_") {
+			sl.ReportError(details.AuthorizationCode, "AuthorizationCode", "AuthorizationCode", "authorization_code", "")
+		}
+	}
+}
+
+// list recipients
+type ListRecipients struct {
+	PageFilter
+}
+
+// update recipient, empty fields are left unchanged
+type UpdateRecipientInput struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty" validate:"omitempty,email"`
+}
+
+type RecipientListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []RecipientData `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+type BulkRecipientResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Success []RecipientData `json:"success"`
+		Errors  []struct {
+			Error   string         `json:"error"`
+			Payload AccountDetails `json:"payload"`
+		} `json:"errors"`
+	} `json:"data"`
+}
+
+func (p *Paystack) ListRecipients(filter ListRecipients) (*RecipientListResponse, error) {
+	return p.ListRecipientsContext(context.Background(), filter)
+}
+
+// ListRecipientsContext is like ListRecipients but aborts the call when ctx is cancelled.
+func (p *Paystack) ListRecipientsContext(ctx context.Context, filter ListRecipients) (*RecipientListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[RecipientListResponse](ctx, p, "transferrecipient.list", http.MethodGet, "/transferrecipient", query, nil)
+}
+
+// FetchRecipient returns a recipient by ID or recipient code.
+func (p *Paystack) FetchRecipient(idOrCode string) (*Recipient, error) {
+	return p.FetchRecipientContext(context.Background(), idOrCode)
+}
+
+// FetchRecipientContext is like FetchRecipient but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchRecipientContext(ctx context.Context, idOrCode string) (*Recipient, error) {
+	if idOrCode == "" {
+		return nil, errors.New("recipient id or code is required")
+	}
+	return do[Recipient](ctx, p, "transferrecipient.fetch", http.MethodGet, "/transferrecipient/"+url.PathEscape(idOrCode), nil, nil)
+}
+
+// UpdateRecipient changes the name or email of a recipient by ID or recipient code.
+func (p *Paystack) UpdateRecipient(idOrCode string, payload UpdateRecipientInput) (*MessageResponse, error) {
+	return p.UpdateRecipientContext(context.Background(), idOrCode, payload)
+}
+
+// UpdateRecipientContext is like UpdateRecipient but aborts the call when ctx is cancelled.
+func (p *Paystack) UpdateRecipientContext(ctx context.Context, idOrCode string, payload UpdateRecipientInput) (*MessageResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("recipient id or code is required")
+	}
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[MessageResponse](ctx, p, "transferrecipient.update", http.MethodPut, "/transferrecipient/"+url.PathEscape(idOrCode), nil, payload)
+}
+
+// DeleteRecipient deactivates a recipient by ID or recipient code.
+func (p *Paystack) DeleteRecipient(idOrCode string) (*MessageResponse, error) {
+	return p.DeleteRecipientContext(context.Background(), idOrCode)
+}
+
+// DeleteRecipientContext is like DeleteRecipient but aborts the call when ctx is cancelled.
+func (p *Paystack) DeleteRecipientContext(ctx context.Context, idOrCode string) (*MessageResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("recipient id or code is required")
+	}
+	return do[MessageResponse](ctx, p, "transferrecipient.delete", http.MethodDelete, "/transferrecipient/"+url.PathEscape(idOrCode), nil, nil)
+}
+
+// BulkCreateRecipients creates several recipients at once. Recipients Paystack
+// rejects are listed in Data.Errors rather than failing the whole call.
+func (p *Paystack) BulkCreateRecipients(batch []AccountDetails) (*BulkRecipientResponse, error) {
+	return p.BulkCreateRecipientsContext(context.Background(), batch)
+}
+
+// BulkCreateRecipientsContext is like BulkCreateRecipients but aborts the call when ctx is cancelled.
+func (p *Paystack) BulkCreateRecipientsContext(ctx context.Context, batch []AccountDetails) (*BulkRecipientResponse, error) {
+	if len(batch) == 0 {
+		return nil, errors.New("batch must contain at least one recipient")
+	}
+	//validate arguments
+	for i, recipient := range batch {
+		if err := Validate(recipient); err != nil {
+			return nil, fmt.Errorf("Error validating recipient %d: %v", i, err)
+		}
+	}
+	requestBody := map[string]interface{}{
+		"batch": batch,
+	}
+	return do[BulkRecipientResponse](ctx, p, "transferrecipient.bulk", http.MethodPost, "/transferrecipient/bulk", nil, requestBody)
+}
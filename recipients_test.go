@@ -0,0 +1,62 @@
+package paystack
+
+import (
+	"testing"
+)
+
+func TestAccountDetailsValidation(t *testing.T) {
+	cases := []struct {
+		name    string
+		details AccountDetails
+		valid   bool
+	}{
+		{"nuban without description", AccountDetails{Type: RecipientNUBAN, Name: "Tolu", AccountNumber: "0001234567", BankCode: "058", Currency: "NGN"}, true},
+		{"nuban with short account", AccountDetails{Type: RecipientNUBAN, Name: "Tolu", AccountNumber: "12345", BankCode: "058", Currency: "NGN"}, false},
+		{"mobile money", AccountDetails{Type: RecipientMobileMoney, Name: "Abina", AccountNumber: "0551234987", BankCode: "MTN", Currency: "GHS"}, true},
+		{"mobile money without provider", AccountDetails{Type: RecipientMobileMoney, Name: "Abina", AccountNumber: "0551234987", Currency: "GHS"}, false},
+		{"basa", AccountDetails{Type: RecipientBASA, Name: "Thandi", AccountNumber: "1234567890", BankCode: "632005", Currency: "ZAR"}, true},
+		{"authorization", AccountDetails{Type: RecipientAuthorization, Name: "Ada", AuthorizationCode: "AUTH_ncx8hews93", Email: "ada@test.com", Currency: "NGN"}, true},
+		{"authorization without email", AccountDetails{Type: RecipientAuthorization, Name: "Ada", AuthorizationCode: "AUTH_ncx8hews93", Currency: "NGN"}, false},
+		{"authorization with bad code", AccountDetails{Type: RecipientAuthorization, Name: "Ada", AuthorizationCode: "ncx8hews93", Email: "ada@test.com", Currency: "NGN"}, false},
+		{"unknown type", AccountDetails{Type: "paypal", Name: "Ada", AccountNumber: "1", BankCode: "1", Currency: "USD"}, false},
+	}
+	for _, c := range cases {
+		err := Validate(c.details)
+		if (err == nil) != c.valid {
+			t.Errorf("%s: expected valid=%v, but got: %v", c.name, c.valid, err)
+		}
+	}
+}
+
+func TestBulkCreateRecipients(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/transferrecipient/bulk",
+		`{"status":true,"message":"Recipients added successfully","data":{"success":[{"name":"Habenero Mundane","recipient_code":"RCP_dhl1pvtd7crmp8p","type":"nuban"}],"errors":[{"error":"Account number is invalid","payload":{"type":"nuban","name":"Soft Merry","account_number":"0","bank_code":"058","currency":"NGN"}}]}}`,
+		&received)
+
+	resp, err := p.BulkCreateRecipients([]AccountDetails{
+		{Type: RecipientNUBAN, Name: "Habenero Mundane", AccountNumber: "0123456789", BankCode: "033", Currency: "NGN"},
+		{Type: RecipientNUBAN, Name: "Soft Merry", AccountNumber: "9876543210", BankCode: "058", Currency: "NGN"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if batch, ok := received["batch"].([]interface{}); !ok || len(batch) != 2 {
+		t.Errorf("Unexpected payload: %v", received)
+	}
+	if len(resp.Data.Success) != 1 || resp.Data.Success[0].RecipientCode != "RCP_dhl1pvtd7crmp8p" {
+		t.Errorf("Unexpected successes: %+v", resp.Data.Success)
+	}
+	if len(resp.Data.Errors) != 1 || resp.Data.Errors[0].Payload.Name != "Soft Merry" {
+		t.Errorf("Unexpected errors: %+v", resp.Data.Errors)
+	}
+}
+
+func TestDeleteRecipient(t *testing.T) {
+	p, _ := stubClient(t, "DELETE", "/transferrecipient/RCP_2x5j67tnnw1t98k",
+		`{"status":true,"message":"Transfer recipient set as inactive"}`, nil)
+	resp, err := p.DeleteRecipient("RCP_2x5j67tnnw1t98k")
+	if err != nil || resp.Message != "Transfer recipient set as inactive" {
+		t.Errorf("Unexpected result: %+v, %v", resp, err)
+	}
+}
@@ -0,0 +1,162 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+type RefundStatus string
+
+const (
+	RefundPending        RefundStatus = "pending"
+	RefundProcessing     RefundStatus = "processing"
+	RefundProcessed      RefundStatus = "processed"
+	RefundFailed         RefundStatus = "failed"
+	RefundNeedsAttention RefundStatus = "needs-attention"
+)
+
+// create refund, leave Amount zero for a full refund
+type RefundInput struct {
+	Transaction  string `json:"transaction" validate:"required"` // transaction reference or ID
+	Amount       Money  `json:"amount,omitempty" validate:"gte=0"`
+	Currency     string `json:"currency,omitempty" validate:"omitempty,len=3"`
+	CustomerNote string `json:"customer_note,omitempty"`
+	MerchantNote string `json:"merchant_note,omitempty"`
+}
+
+// list refunds
+type ListRefunds struct {
+	PageFilter
+	Transaction string `json:"transaction" schema:"transaction"` // transaction reference or ID
+	Currency    string `json:"currency" schema:"currency"`
+}
+
+// RefundTransaction is the refunded transaction. Paystack sends either its ID
+// alone or the whole transaction object, in which case all fields are set.
+type RefundTransaction struct {
+	ID        int64  `json:"id"`
+	Reference string `json:"reference"`
+	Amount    Money  `json:"amount"`
+	Currency  string `json:"currency"`
+	Status    string `json:"status"`
+}
+
+type Refund struct {
+	ID             int64             `json:"id"`
+	Integration    int64             `json:"integration"`
+	Domain         string            `json:"domain"`
+	Transaction    RefundTransaction `json:"transaction"`
+	Dispute        interface{}       `json:"dispute"`
+	Amount         Money             `json:"amount"`
+	DeductedAmount Money             `json:"deducted_amount"`
+	FullyDeducted  bool              `json:"fully_deducted"`
+	Currency       string            `json:"currency"`
+	Channel        *string           `json:"channel"` // Use a pointer to allow for null values
+	Status         RefundStatus      `json:"status"`
+	RefundedBy     string            `json:"refunded_by"`
+	CustomerNote   string            `json:"customer_note"`
+	MerchantNote   string            `json:"merchant_note"`
+	ExpectedAt     string            `json:"expected_at"`
+	RefundedAt     *string           `json:"refunded_at"` // Use a pointer to allow for null values
+	CreatedAt      string            `json:"createdAt"`
+	UpdatedAt      string            `json:"updatedAt"`
+}
+
+type RefundResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    Refund `json:"data"`
+}
+
+type RefundListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Refund        `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+// MarshalJSON leaves out a zero Amount so that the refund is a full one.
+func (in RefundInput) MarshalJSON() ([]byte, error) {
+	type alias RefundInput
+	payload := struct {
+		alias
+		Amount *Money `json:"amount,omitempty"`
+	}{alias: alias(in)}
+	if !in.Amount.IsZero() {
+		payload.Amount = &in.Amount
+	}
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON accepts either a bare transaction ID or a transaction object.
+func (t *RefundTransaction) UnmarshalJSON(data []byte) error {
+	type alias RefundTransaction
+	*t = RefundTransaction{}
+	if err := unmarshalIDOrObject(data, &t.ID, (*alias)(t)); err != nil {
+		return err
+	}
+	t.Amount.Currency = Currency(t.Currency)
+	return nil
+}
+
+// UnmarshalJSON fills in the currency of the amounts from the refund's currency.
+func (r *Refund) UnmarshalJSON(data []byte) error {
+	type alias Refund
+	if err := json.Unmarshal(data, (*alias)(r)); err != nil {
+		return err
+	}
+	r.Amount.Currency = Currency(r.Currency)
+	r.DeductedAmount.Currency = Currency(r.Currency)
+	return nil
+}
+
+// CreateRefund refunds a transaction fully, or partially when Amount is set.
+func (p *Paystack) CreateRefund(payload RefundInput) (*RefundResponse, error) {
+	return p.CreateRefundContext(context.Background(), payload)
+}
+
+// CreateRefundContext is like CreateRefund but aborts the call when ctx is cancelled.
+func (p *Paystack) CreateRefundContext(ctx context.Context, payload RefundInput) (*RefundResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	if payload.Currency, err = resolveCurrency(payload.Currency, payload.Amount); err != nil {
+		return nil, err
+	}
+	return do[RefundResponse](ctx, p, "refund.create", http.MethodPost, "/refund", nil, payload)
+}
+
+func (p *Paystack) ListRefunds(filter ListRefunds) (*RefundListResponse, error) {
+	return p.ListRefundsContext(context.Background(), filter)
+}
+
+// ListRefundsContext is like ListRefunds but aborts the call when ctx is cancelled.
+func (p *Paystack) ListRefundsContext(ctx context.Context, filter ListRefunds) (*RefundListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[RefundListResponse](ctx, p, "refund.list", http.MethodGet, "/refund", query, nil)
+}
+
+func (p *Paystack) FetchRefund(id int64) (*RefundResponse, error) {
+	return p.FetchRefundContext(context.Background(), id)
+}
+
+// FetchRefundContext is like FetchRefund but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchRefundContext(ctx context.Context, id int64) (*RefundResponse, error) {
+	if id <= 0 {
+		return nil, errors.New("refund id must be greater than zero")
+	}
+	return do[RefundResponse](ctx, p, "refund.fetch", http.MethodGet, "/refund/"+strconv.FormatInt(id, 10), nil, nil)
+}
@@ -0,0 +1,43 @@
+package paystack
+
+import (
+	"testing"
+)
+
+func TestCreateRefund(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/refund",
+		`{"status":true,"message":"Refund has been queued for processing","data":{"transaction":{"id":1004723697,"reference":"T685312322670591","amount":10000,"currency":"NGN","status":"reversed"},"integration":412829,"deducted_amount":0,"merchant_note":"Refund for transaction T685312322670591 by test@me.com","status":"pending","currency":"NGN","amount":10000,"fully_deducted":false,"id":1}}`,
+		&received)
+
+	resp, err := p.CreateRefund(RefundInput{Transaction: "T685312322670591", CustomerNote: "sorry"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if _, ok := received["amount"]; ok {
+		t.Errorf("Expected amount to be omitted for a full refund, but got: %v", received)
+	}
+	if resp.Data.Status != RefundPending || resp.Data.Transaction.Reference != "T685312322670591" {
+		t.Errorf("Unexpected refund: %+v", resp.Data)
+	}
+	if resp.Data.Amount != NewMoney(10000, NGN) || resp.Data.Transaction.Amount != NewMoney(10000, NGN) {
+		t.Errorf("Unexpected amounts: %v, %v", resp.Data.Amount, resp.Data.Transaction.Amount)
+	}
+}
+
+func TestListRefunds(t *testing.T) {
+	p, last := stubClient(t, "GET", "/refund",
+		`{"status":true,"message":"Refunds retrieved","data":[{"id":1,"transaction":1641,"amount":500000,"currency":"NGN","status":"processed"}],"meta":{"total":1,"perPage":"50","page":1,"pageCount":1}}`,
+		nil)
+
+	resp, err := p.ListRefunds(ListRefunds{PageFilter: PageFilter{PerPage: 50}, Currency: "NGN"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if last.URL.Query().Get("currency") != "NGN" || last.URL.Query().Get("perPage") != "50" {
+		t.Errorf("Unexpected query: %s", last.URL.RawQuery)
+	}
+	if resp.Data[0].Transaction.ID != 1641 || resp.Data[0].Status != RefundProcessed {
+		t.Errorf("Unexpected refund: %+v", resp.Data[0])
+	}
+}
@@ -0,0 +1,88 @@
+package requests
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting how many calls per second are sent.
+// It is safe for concurrent use and can be shared between clients.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter allows perSecond calls per second with bursts of up to burst calls.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a call may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	// reserve a token, going into debt if none is available
+	l.tokens--
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, wait); err != nil {
+		// hand the reservation back so cancelled callers don't slow down others
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// WithRateLimiter makes every attempt, including retries, wait on limiter first.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Request) {
+		c.Limiter = limiter
+	}
+}
+
+var (
+	sharedLimitersMu sync.Mutex
+	sharedLimiters   = make(map[string]*RateLimiter)
+)
+
+// SharedRateLimiter returns the limiter registered for apiKey, creating it with
+// the given settings on first use. Later calls with the same key reuse the first
+// limiter and ignore their settings.
+func SharedRateLimiter(apiKey string, perSecond float64, burst int) *RateLimiter {
+	sharedLimitersMu.Lock()
+	defer sharedLimitersMu.Unlock()
+
+	if limiter, ok := sharedLimiters[apiKey]; ok {
+		return limiter
+	}
+	limiter := NewRateLimiter(perSecond, burst)
+	sharedLimiters[apiKey] = limiter
+	return limiter
+}
@@ -0,0 +1,46 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurstThenWaits(t *testing.T) {
+	limiter := NewRateLimiter(20, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+	// the first two calls use the burst, the third waits ~50ms for a token
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Expected the third call to wait for a token, but it took %v", elapsed)
+	}
+}
+
+func TestRateLimiterRespectsContext(t *testing.T) {
+	limiter := NewRateLimiter(0.1, 1)
+	limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, but got: %v", err)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	a := SharedRateLimiter("sk_shared", 5, 1)
+	b := SharedRateLimiter("sk_shared", 50, 10)
+	if a != b {
+		t.Errorf("Expected clients with the same key to share a limiter")
+	}
+	if a == SharedRateLimiter("sk_other", 5, 1) {
+		t.Errorf("Expected clients with different keys to use different limiters")
+	}
+}
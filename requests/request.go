@@ -2,56 +2,190 @@ package requests
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
+	"strings"
 )
 
 const baseUrl = "https://api.paystack.co"
 
 type Request struct {
-	APIKey string
+	APIKey     string
+	BaseURL    string
+	HTTPClient *http.Client
+	UserAgent  string
+	Headers    map[string]string
+	Retry      *RetryPolicy
+	Limiter    *RateLimiter
+}
+
+// Option configures a Request.
+type Option func(*Request)
+
+// WithBaseURL overrides the Paystack API base URL, e.g. to point at a local stand-in.
+func WithBaseURL(url string) Option {
+	return func(c *Request) {
+		c.BaseURL = strings.TrimRight(url, "/")
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Request) {
+		c.HTTPClient = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Request) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithHeaders adds default headers sent with every request.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Request) {
+		if c.Headers == nil {
+			c.Headers = make(map[string]string, len(headers))
+		}
+		for key, value := range headers {
+			c.Headers[key] = value
+		}
+	}
 }
 
 // NewAPIClient creates a new instance of APIClient.
-func NewAPIClient(apiKey string) *Request {
-	return &Request{
-		APIKey: apiKey,
+func NewAPIClient(apiKey string, opts ...Option) *Request {
+	c := &Request{
+		APIKey:  apiKey,
+		BaseURL: baseUrl,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // Post sends a POST request to the specified endpoint with the given payload.
 func (c *Request) Post(endpoint string, payload interface{}) (*http.Response, error) {
-	url := baseUrl + endpoint
+	return c.PostContext(context.Background(), endpoint, payload)
+}
 
-	// Convert payload to JSON
-	payloadBytes, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
+// PostContext is like Post but aborts the request when ctx is cancelled.
+func (c *Request) PostContext(ctx context.Context, endpoint string, payload interface{}) (*http.Response, error) {
+	return c.Do(ctx, http.MethodPost, endpoint, payload)
+}
+
+// Get sends a get request the specified endpoint
+func (c *Request) Get(endpoint string) (*http.Response, error) {
+	return c.GetContext(context.Background(), endpoint)
+}
+
+// GetContext is like Get but aborts the request when ctx is cancelled.
+func (c *Request) GetContext(ctx context.Context, endpoint string) (*http.Response, error) {
+	return c.Do(ctx, http.MethodGet, endpoint, nil)
+}
+
+// Do sends a request with the given method to the specified endpoint. A nil payload sends no body.
+func (c *Request) Do(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	var payloadBytes []byte
+	if payload != nil {
+		// Convert payload to JSON
+		var err error
+		payloadBytes, err = json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
 	}
+	return c.send(ctx, method, endpoint, payloadBytes)
+}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
-	if err != nil {
-		return nil, err
+// send performs the request, retrying transient failures according to the retry policy.
+func (c *Request) send(ctx context.Context, method, endpoint string, payload []byte) (*http.Response, error) {
+	attempts := 1
+	if c.Retry != nil && c.Retry.MaxAttempts > 1 && isIdempotent(ctx, method) {
+		attempts = c.Retry.MaxAttempts
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	for attempt := 1; ; attempt++ {
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
 
-	client := http.DefaultClient
-	return client.Do(req)
-}
+		var body io.Reader
+		if payload != nil {
+			body = bytes.NewReader(payload)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+endpoint, body)
+		if err != nil {
+			return nil, err
+		}
+		c.setHeaders(req)
+		if header, ok := ctx.Value(headersKey{}).(http.Header); ok {
+			for key, values := range header {
+				key = http.CanonicalHeaderKey(key)
+				if key == "Authorization" {
+					continue
+				}
+				req.Header[key] = values
+			}
+		}
 
-//Get sends a get request the specified endpoint
-func (c *Request) Get(endpoint string) (*http.Response, error) {
-	url := baseUrl + endpoint
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+		resp, err := c.Client().Do(req)
+		if attempt >= attempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		wait := c.Retry.backoff(attempt)
+		if err == nil {
+			if !shouldRetryStatus(resp.StatusCode) {
+				return resp, nil
+			}
+			if after, ok := retryAfter(resp); ok {
+				if c.Retry.MaxBackoff > 0 && after > c.Retry.MaxBackoff {
+					// waiting that long is beyond the policy, hand the response back instead
+					return resp, nil
+				}
+				wait = after
+			}
+			discard(resp)
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
+}
 
+// setHeaders applies the default headers, user agent and authorization to req.
+func (c *Request) setHeaders(req *http.Request) {
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+}
+
+// Client returns the configured http.Client, falling back to http.DefaultClient.
+func (c *Request) Client() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+type headersKey struct{}
 
-	client := http.DefaultClient
-	return client.Do(req)
+// ContextWithHeaders attaches extra headers to calls made with the returned context.
+// They override the default headers but never the Authorization header.
+func ContextWithHeaders(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, headersKey{}, header)
 }
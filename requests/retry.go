@@ -0,0 +1,114 @@
+package requests
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed calls are retried.
+//
+// GET requests are retried on network errors, 429 and 5xx responses. POST
+// requests are never retried unless their context was marked with Idempotent.
+type RetryPolicy struct {
+	MaxAttempts    int           // total attempts including the first one, values below 2 disable retries
+	InitialBackoff time.Duration // wait before the first retry
+	MaxBackoff     time.Duration // upper bound for a single wait, a longer Retry-After ends the retries
+	Multiplier     float64       // growth factor applied after every retry
+	Jitter         float64       // random +/- fraction applied to every wait, between 0 and 1
+}
+
+// DefaultRetryPolicy retries up to twice with exponential backoff starting at 500ms.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// WithRetryPolicy enables retries for transient failures.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Request) {
+		c.Retry = &policy
+	}
+}
+
+type idempotentKey struct{}
+
+// Idempotent marks calls made with the returned context as safe to retry even
+// when they are POSTs, e.g. because the payload carries a unique reference.
+func Idempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// isIdempotent reports whether a call with the given method and context may be retried.
+func isIdempotent(ctx context.Context, method string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return true
+	}
+	marked, _ := ctx.Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// shouldRetryStatus reports whether a response status is transient.
+func shouldRetryStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// backoff returns the wait before retry number attempt (starting at 1).
+func (r *RetryPolicy) backoff(attempt int) time.Duration {
+	wait := float64(r.InitialBackoff)
+	for i := 1; i < attempt; i++ {
+		if r.Multiplier > 1 {
+			wait *= r.Multiplier
+		}
+	}
+	if r.MaxBackoff > 0 && wait > float64(r.MaxBackoff) {
+		wait = float64(r.MaxBackoff)
+	}
+	if r.Jitter > 0 {
+		wait += wait * r.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(wait)
+}
+
+// retryAfter parses the Retry-After header, which is either seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// discard drains and closes a response body so the connection can be reused.
+func discard(resp *http.Response) {
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
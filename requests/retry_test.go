@@ -0,0 +1,94 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Multiplier: 2}
+
+func flakyServer(failures int32, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"status":true}`))
+	}))
+}
+
+func TestGetRetriesTransientFailures(t *testing.T) {
+	var calls int32
+	server := flakyServer(2, &calls)
+	defer server.Close()
+
+	c := NewAPIClient("api-key", WithBaseURL(server.URL), WithRetryPolicy(fastRetry))
+	resp, err := c.Get("/bank")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Errorf("Expected success after 3 calls, but got status %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestPostIsNotRetriedUnlessIdempotent(t *testing.T) {
+	var calls int32
+	server := flakyServer(1, &calls)
+	defer server.Close()
+
+	c := NewAPIClient("api-key", WithBaseURL(server.URL), WithRetryPolicy(fastRetry))
+	resp, err := c.Post("/transfer", map[string]string{"reference": "ref"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadGateway || calls != 1 {
+		t.Errorf("Expected a single failed call, but got status %d after %d calls", resp.StatusCode, calls)
+	}
+
+	resp, err = c.PostContext(Idempotent(context.Background()), "/transfer", map[string]string{"reference": "ref"})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 2 {
+		t.Errorf("Expected idempotent post to be retried, but got status %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second, Multiplier: 2}
+	if got := policy.backoff(1); got != time.Second {
+		t.Errorf("Expected first backoff of 1s, but got: %v", got)
+	}
+	if got := policy.backoff(5); got != 3*time.Second {
+		t.Errorf("Expected backoff to be capped at 3s, but got: %v", got)
+	}
+}
+
+func TestLongRetryAfterIsNotWaitedFor(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := NewAPIClient("api-key", WithBaseURL(server.URL), WithRetryPolicy(fastRetry))
+	resp, err := c.Get("/bank")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || calls != 1 {
+		t.Errorf("Expected the 429 to be returned at once, but got status %d after %d calls", resp.StatusCode, calls)
+	}
+}
@@ -0,0 +1,193 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// SplitType is how the shares of a split are expressed.
+type SplitType string
+
+const (
+	SplitPercentage SplitType = "percentage" // shares are percentages of the payment
+	SplitFlat       SplitType = "flat"       // shares are amounts in the minor unit
+)
+
+// SplitBearer is who pays the Paystack fees of a split payment.
+type SplitBearer string
+
+const (
+	SplitBearerAccount         SplitBearer = "account"
+	SplitBearerSubaccount      SplitBearer = "subaccount" // the one set as BearerSubaccount
+	SplitBearerAllProportional SplitBearer = "all-proportional"
+	SplitBearerAll             SplitBearer = "all"
+)
+
+// SplitShare is the share of a subaccount in a split.
+type SplitShare struct {
+	Subaccount string `json:"subaccount" validate:"required"` // subaccount code
+	Share      int64  `json:"share" validate:"gt=0"`          // percentage or amount depending on the split type
+}
+
+// create split
+type SplitInput struct {
+	Name             string       `json:"name" validate:"required"`
+	Type             SplitType    `json:"type" validate:"required,oneof=percentage flat"`
+	Currency         string       `json:"currency" validate:"required,len=3"`
+	Subaccounts      []SplitShare `json:"subaccounts" validate:"required,min=1,dive"`
+	BearerType       SplitBearer  `json:"bearer_type" validate:"required,oneof=account subaccount all-proportional all"`
+	BearerSubaccount string       `json:"bearer_subaccount,omitempty" validate:"required_if=BearerType subaccount"` // subaccount code
+}
+
+// update split, empty fields are left unchanged
+type UpdateSplitInput struct {
+	Name             string      `json:"name,omitempty"`
+	Active           *bool       `json:"active,omitempty"`
+	BearerType       SplitBearer `json:"bearer_type,omitempty" validate:"omitempty,oneof=account subaccount all-proportional all"`
+	BearerSubaccount string      `json:"bearer_subaccount,omitempty" validate:"required_if=BearerType subaccount"` // subaccount code
+}
+
+// list splits
+type ListSplits struct {
+	PageFilter
+	Name   string `json:"name" schema:"name"`
+	Active *bool  `json:"active" schema:"active"`
+	SortBy string `json:"sort_by" schema:"sort_by"`
+}
+
+// SplitSubaccount is a subaccount of a split with its share.
+type SplitSubaccount struct {
+	Subaccount Subaccount `json:"subaccount"`
+	Share      int64      `json:"share"`
+}
+
+// Split divides payments between the main account and subaccounts.
+type Split struct {
+	ID               int64             `json:"id"`
+	Name             string            `json:"name"`
+	SplitCode        string            `json:"split_code"`
+	Type             SplitType         `json:"type"`
+	Currency         string            `json:"currency"`
+	Active           bool              `json:"active"`
+	BearerType       SplitBearer       `json:"bearer_type"`
+	BearerSubaccount interface{}       `json:"bearer_subaccount"` // null, ID or code
+	Subaccounts      []SplitSubaccount `json:"subaccounts"`
+	TotalSubaccounts int               `json:"total_subaccounts"`
+	IsDynamic        bool              `json:"is_dynamic"`
+	Integration      int64             `json:"integration"`
+	Domain           string            `json:"domain"`
+	CreatedAt        string            `json:"createdAt"`
+	UpdatedAt        string            `json:"updatedAt"`
+}
+
+type SplitResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    Split  `json:"data"`
+}
+
+type SplitListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Split         `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+// CreateSplit creates a split to pass as InitializeInput.SplitCode.
+func (p *Paystack) CreateSplit(payload SplitInput) (*SplitResponse, error) {
+	return p.CreateSplitContext(context.Background(), payload)
+}
+
+// CreateSplitContext is like CreateSplit but aborts the call when ctx is cancelled.
+func (p *Paystack) CreateSplitContext(ctx context.Context, payload SplitInput) (*SplitResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[SplitResponse](ctx, p, "split.create", http.MethodPost, "/split", nil, payload)
+}
+
+func (p *Paystack) ListSplits(filter ListSplits) (*SplitListResponse, error) {
+	return p.ListSplitsContext(context.Background(), filter)
+}
+
+// ListSplitsContext is like ListSplits but aborts the call when ctx is cancelled.
+func (p *Paystack) ListSplitsContext(ctx context.Context, filter ListSplits) (*SplitListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[SplitListResponse](ctx, p, "split.list", http.MethodGet, "/split", query, nil)
+}
+
+// FetchSplit returns a split by ID.
+func (p *Paystack) FetchSplit(id string) (*SplitResponse, error) {
+	return p.FetchSplitContext(context.Background(), id)
+}
+
+// FetchSplitContext is like FetchSplit but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchSplitContext(ctx context.Context, id string) (*SplitResponse, error) {
+	if id == "" {
+		return nil, errors.New("split id is required")
+	}
+	return do[SplitResponse](ctx, p, "split.fetch", http.MethodGet, "/split/"+url.PathEscape(id), nil, nil)
+}
+
+func (p *Paystack) UpdateSplit(id string, payload UpdateSplitInput) (*SplitResponse, error) {
+	return p.UpdateSplitContext(context.Background(), id, payload)
+}
+
+// UpdateSplitContext is like UpdateSplit but aborts the call when ctx is cancelled.
+func (p *Paystack) UpdateSplitContext(ctx context.Context, id string, payload UpdateSplitInput) (*SplitResponse, error) {
+	if id == "" {
+		return nil, errors.New("split id is required")
+	}
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[SplitResponse](ctx, p, "split.update", http.MethodPut, "/split/"+url.PathEscape(id), nil, payload)
+}
+
+// AddSplitSubaccount adds a subaccount to a split, or updates its share if it is already part of it.
+func (p *Paystack) AddSplitSubaccount(id string, share SplitShare) (*SplitResponse, error) {
+	return p.AddSplitSubaccountContext(context.Background(), id, share)
+}
+
+// AddSplitSubaccountContext is like AddSplitSubaccount but aborts the call when ctx is cancelled.
+func (p *Paystack) AddSplitSubaccountContext(ctx context.Context, id string, share SplitShare) (*SplitResponse, error) {
+	if id == "" {
+		return nil, errors.New("split id is required")
+	}
+	//validate arguments
+	err := Validate(share)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[SplitResponse](ctx, p, "split.add_subaccount", http.MethodPost, "/split/"+url.PathEscape(id)+"/subaccount/add", nil, share)
+}
+
+// RemoveSplitSubaccount removes a subaccount, by code, from a split.
+func (p *Paystack) RemoveSplitSubaccount(id, subaccount string) (*MessageResponse, error) {
+	return p.RemoveSplitSubaccountContext(context.Background(), id, subaccount)
+}
+
+// RemoveSplitSubaccountContext is like RemoveSplitSubaccount but aborts the call when ctx is cancelled.
+func (p *Paystack) RemoveSplitSubaccountContext(ctx context.Context, id, subaccount string) (*MessageResponse, error) {
+	if id == "" || subaccount == "" {
+		return nil, errors.New("split id and subaccount code are required")
+	}
+	requestBody := map[string]interface{}{
+		"subaccount": subaccount,
+	}
+	return do[MessageResponse](ctx, p, "split.remove_subaccount", http.MethodPost, "/split/"+url.PathEscape(id)+"/subaccount/remove", nil, requestBody)
+}
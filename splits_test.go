@@ -0,0 +1,89 @@
+package paystack
+
+import (
+	"testing"
+)
+
+const splitResponse = `{"status":true,"message":"Split created","data":{"id":2703655,"name":"Marketplace","type":"percentage","currency":"NGN","integration":463433,"domain":"test","split_code":"SPL_RcScyW5jp2","active":true,"bearer_type":"all","bearer_subaccount":null,"subaccounts":[{"subaccount":{"id":1151727,"subaccount_code":"ACCT_6uujpqtzmnufzkw","business_name":"Oasis Store","percentage_charge":18.2,"settlement_bank":"Guaranty Trust Bank","account_number":"0193274682","currency":"NGN","active":true},"share":30}],"total_subaccounts":1}}`
+
+func TestCreateSplit(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/split", splitResponse, &received)
+
+	resp, err := p.CreateSplit(SplitInput{
+		Name:        "Marketplace",
+		Type:        SplitPercentage,
+		Currency:    "NGN",
+		Subaccounts: []SplitShare{{Subaccount: "ACCT_6uujpqtzmnufzkw", Share: 30}},
+		BearerType:  SplitBearerAll,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["bearer_type"] != "all" || len(received["subaccounts"].([]interface{})) != 1 {
+		t.Errorf("Unexpected body: %v", received)
+	}
+	if _, ok := received["bearer_subaccount"]; ok {
+		t.Errorf("Expected bearer_subaccount to be omitted, but got: %v", received)
+	}
+	share := resp.Data.Subaccounts[0]
+	if resp.Data.SplitCode != "SPL_RcScyW5jp2" || share.Share != 30 || share.Subaccount.SubaccountCode != "ACCT_6uujpqtzmnufzkw" {
+		t.Errorf("Unexpected split: %+v", resp.Data)
+	}
+}
+
+func TestCreateSplitRequiresBearerSubaccount(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	_, err := p.CreateSplit(SplitInput{
+		Name:        "Marketplace",
+		Type:        SplitFlat,
+		Currency:    "NGN",
+		Subaccounts: []SplitShare{{Subaccount: "ACCT_6uujpqtzmnufzkw", Share: 5000}},
+		BearerType:  SplitBearerSubaccount,
+	})
+	if err == nil {
+		t.Fatal("Expected an error without a bearer subaccount")
+	}
+}
+
+func TestAddSplitSubaccount(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/split/2703655/subaccount/add", splitResponse, &received)
+
+	if _, err := p.AddSplitSubaccount("2703655", SplitShare{Subaccount: "ACCT_6uujpqtzmnufzkw", Share: 30}); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["subaccount"] != "ACCT_6uujpqtzmnufzkw" || received["share"] != float64(30) {
+		t.Errorf("Unexpected body: %v", received)
+	}
+}
+
+func TestRemoveSplitSubaccount(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/split/2703655/subaccount/remove", `{"status":true,"message":"Subaccount removed"}`, &received)
+
+	if _, err := p.RemoveSplitSubaccount("2703655", "ACCT_6uujpqtzmnufzkw"); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["subaccount"] != "ACCT_6uujpqtzmnufzkw" {
+		t.Errorf("Unexpected body: %v", received)
+	}
+}
+
+func TestTransactionSplitAndSubaccount(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/transaction/verify/T685312322670591",
+		`{"status":true,"message":"Verification successful","data":{"id":1,"reference":"T685312322670591","amount":100000,"currency":"NGN","status":"success","split":{"type":"percentage","currency":"NGN","subaccounts":[{"subaccount":"ACCT_6uujpqtzmnufzkw","share":30}],"bearer_type":"all","bearer_subaccount":null,"split_code":"SPL_RcScyW5jp2"},"subaccount":{}}}`,
+		nil)
+
+	resp, err := p.Verify("T685312322670591")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	split := resp.Data.Split
+	if split.SplitCode != "SPL_RcScyW5jp2" || split.Subaccounts[0].Subaccount.SubaccountCode != "ACCT_6uujpqtzmnufzkw" {
+		t.Errorf("Unexpected split: %+v", split)
+	}
+	if resp.Data.Subaccount.SubaccountCode != "" {
+		t.Errorf("Expected no subaccount, but got: %+v", resp.Data.Subaccount)
+	}
+}
@@ -0,0 +1,166 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SettlementSchedule is how often a subaccount is paid out.
+type SettlementSchedule string
+
+const (
+	SettlementAuto    SettlementSchedule = "auto" // the day after each transaction
+	SettlementWeekly  SettlementSchedule = "weekly"
+	SettlementMonthly SettlementSchedule = "monthly"
+	SettlementManual  SettlementSchedule = "manual" // only when requested
+)
+
+// create subaccount
+type SubaccountInput struct {
+	BusinessName        string  `json:"business_name" validate:"required"`
+	SettlementBank      string  `json:"settlement_bank" validate:"required"` // bank code from ListBanks
+	AccountNumber       string  `json:"account_number" validate:"required"`
+	PercentageCharge    float64 `json:"percentage_charge" validate:"gte=0,lte=100"` // share of each payment kept by the main account
+	Description         string  `json:"description,omitempty"`
+	PrimaryContactEmail string  `json:"primary_contact_email,omitempty" validate:"omitempty,email"`
+	PrimaryContactName  string  `json:"primary_contact_name,omitempty"`
+	PrimaryContactPhone string  `json:"primary_contact_phone,omitempty"`
+}
+
+// update subaccount, empty fields are left unchanged
+type UpdateSubaccountInput struct {
+	BusinessName        string             `json:"business_name,omitempty"`
+	SettlementBank      string             `json:"settlement_bank,omitempty"`
+	AccountNumber       string             `json:"account_number,omitempty"`
+	Active              *bool              `json:"active,omitempty"`
+	PercentageCharge    *float64           `json:"percentage_charge,omitempty" validate:"omitempty,gte=0,lte=100"`
+	Description         string             `json:"description,omitempty"`
+	PrimaryContactEmail string             `json:"primary_contact_email,omitempty" validate:"omitempty,email"`
+	PrimaryContactName  string             `json:"primary_contact_name,omitempty"`
+	PrimaryContactPhone string             `json:"primary_contact_phone,omitempty"`
+	SettlementSchedule  SettlementSchedule `json:"settlement_schedule,omitempty" validate:"omitempty,oneof=auto weekly monthly manual"`
+}
+
+// list subaccounts
+type ListSubaccounts struct {
+	PageFilter
+}
+
+// Subaccount is an account that receives a share of payments. Where Paystack
+// only sends its ID or code, the other fields are left empty.
+type Subaccount struct {
+	ID                  int64              `json:"id"`
+	SubaccountCode      string             `json:"subaccount_code"`
+	BusinessName        string             `json:"business_name"`
+	Description         *string            `json:"description"`           // Use a pointer to allow for null values
+	PrimaryContactName  *string            `json:"primary_contact_name"`  // Use a pointer to allow for null values
+	PrimaryContactEmail *string            `json:"primary_contact_email"` // Use a pointer to allow for null values
+	PrimaryContactPhone *string            `json:"primary_contact_phone"` // Use a pointer to allow for null values
+	Metadata            interface{}        `json:"metadata"`              // null, string or object
+	PercentageCharge    float64            `json:"percentage_charge"`
+	IsVerified          bool               `json:"is_verified"`
+	SettlementBank      string             `json:"settlement_bank"` // bank name
+	AccountNumber       string             `json:"account_number"`
+	AccountName         *string            `json:"account_name"` // Use a pointer to allow for null values
+	SettlementSchedule  SettlementSchedule `json:"settlement_schedule"`
+	Active              bool               `json:"active"`
+	Currency            string             `json:"currency"`
+	Integration         int64              `json:"integration"`
+	Domain              string             `json:"domain"`
+	CreatedAt           string             `json:"createdAt"`
+	UpdatedAt           string             `json:"updatedAt"`
+}
+
+type SubaccountResponse struct {
+	Status  bool       `json:"status"`
+	Message string     `json:"message"`
+	Data    Subaccount `json:"data"`
+}
+
+type SubaccountListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Subaccount    `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+// UnmarshalJSON accepts a bare subaccount ID, a subaccount code or a subaccount object.
+func (s *Subaccount) UnmarshalJSON(data []byte) error {
+	*s = Subaccount{}
+	if id, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		s.ID = id
+		return nil
+	}
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		return json.Unmarshal(data, &s.SubaccountCode)
+	}
+	type alias Subaccount
+	return json.Unmarshal(data, (*alias)(s))
+}
+
+func (p *Paystack) CreateSubaccount(payload SubaccountInput) (*SubaccountResponse, error) {
+	return p.CreateSubaccountContext(context.Background(), payload)
+}
+
+// CreateSubaccountContext is like CreateSubaccount but aborts the call when ctx is cancelled.
+func (p *Paystack) CreateSubaccountContext(ctx context.Context, payload SubaccountInput) (*SubaccountResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[SubaccountResponse](ctx, p, "subaccount.create", http.MethodPost, "/subaccount", nil, payload)
+}
+
+func (p *Paystack) ListSubaccounts(filter ListSubaccounts) (*SubaccountListResponse, error) {
+	return p.ListSubaccountsContext(context.Background(), filter)
+}
+
+// ListSubaccountsContext is like ListSubaccounts but aborts the call when ctx is cancelled.
+func (p *Paystack) ListSubaccountsContext(ctx context.Context, filter ListSubaccounts) (*SubaccountListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[SubaccountListResponse](ctx, p, "subaccount.list", http.MethodGet, "/subaccount", query, nil)
+}
+
+// FetchSubaccount returns a subaccount by ID or subaccount code.
+func (p *Paystack) FetchSubaccount(idOrCode string) (*SubaccountResponse, error) {
+	return p.FetchSubaccountContext(context.Background(), idOrCode)
+}
+
+// FetchSubaccountContext is like FetchSubaccount but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchSubaccountContext(ctx context.Context, idOrCode string) (*SubaccountResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("subaccount id or code is required")
+	}
+	return do[SubaccountResponse](ctx, p, "subaccount.fetch", http.MethodGet, "/subaccount/"+url.PathEscape(idOrCode), nil, nil)
+}
+
+func (p *Paystack) UpdateSubaccount(idOrCode string, payload UpdateSubaccountInput) (*SubaccountResponse, error) {
+	return p.UpdateSubaccountContext(context.Background(), idOrCode, payload)
+}
+
+// UpdateSubaccountContext is like UpdateSubaccount but aborts the call when ctx is cancelled.
+func (p *Paystack) UpdateSubaccountContext(ctx context.Context, idOrCode string, payload UpdateSubaccountInput) (*SubaccountResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("subaccount id or code is required")
+	}
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[SubaccountResponse](ctx, p, "subaccount.update", http.MethodPut, "/subaccount/"+url.PathEscape(idOrCode), nil, payload)
+}
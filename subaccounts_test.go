@@ -0,0 +1,63 @@
+package paystack
+
+import (
+	"testing"
+)
+
+func TestCreateSubaccount(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/subaccount",
+		`{"status":true,"message":"Subaccount created","data":{"business_name":"Oasis Store","account_number":"0193274682","percentage_charge":18.2,"settlement_bank":"Guaranty Trust Bank","currency":"NGN","integration":428626,"domain":"test","subaccount_code":"ACCT_6uujpqtzmnufzkw","is_verified":false,"settlement_schedule":"AUTO","active":true,"id":1151727}}`,
+		&received)
+
+	resp, err := p.CreateSubaccount(SubaccountInput{BusinessName: "Oasis Store", SettlementBank: "058", AccountNumber: "0193274682", PercentageCharge: 18.2})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["settlement_bank"] != "058" || received["percentage_charge"] != 18.2 {
+		t.Errorf("Unexpected body: %v", received)
+	}
+	if resp.Data.SubaccountCode != "ACCT_6uujpqtzmnufzkw" || resp.Data.PercentageCharge != 18.2 {
+		t.Errorf("Unexpected subaccount: %+v", resp.Data)
+	}
+}
+
+func TestCreateSubaccountInvalidCharge(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	if _, err := p.CreateSubaccount(SubaccountInput{BusinessName: "Oasis Store", SettlementBank: "058", AccountNumber: "0193274682", PercentageCharge: 120}); err == nil {
+		t.Fatal("Expected an error for a charge above 100 percent")
+	}
+}
+
+func TestUpdateSubaccount(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "PUT", "/subaccount/ACCT_6uujpqtzmnufzkw",
+		`{"status":true,"message":"Subaccount updated","data":{"subaccount_code":"ACCT_6uujpqtzmnufzkw","active":false,"settlement_schedule":"weekly","id":1151727}}`,
+		&received)
+
+	active := false
+	resp, err := p.UpdateSubaccount("ACCT_6uujpqtzmnufzkw", UpdateSubaccountInput{Active: &active, SettlementSchedule: SettlementWeekly})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if len(received) != 2 || received["active"] != false || received["settlement_schedule"] != "weekly" {
+		t.Errorf("Expected only active and settlement_schedule to be sent, but got: %v", received)
+	}
+	if resp.Data.Active || resp.Data.SettlementSchedule != SettlementWeekly {
+		t.Errorf("Unexpected subaccount: %+v", resp.Data)
+	}
+}
+
+func TestListSubaccounts(t *testing.T) {
+	p, last := stubClient(t, "GET", "/subaccount",
+		`{"status":true,"message":"Subaccounts retrieved","data":[{"subaccount_code":"ACCT_6uujpqtzmnufzkw","business_name":"Oasis Store","id":1151727}],"meta":{"total":1,"skipped":0,"perPage":"20","page":1,"pageCount":1}}`,
+		nil)
+
+	resp, err := p.ListSubaccounts(ListSubaccounts{PageFilter{PerPage: 20}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if last.URL.Query().Get("perPage") != "20" || resp.Data[0].BusinessName != "Oasis Store" {
+		t.Errorf("Unexpected result for %s: %+v", last.URL.RawQuery, resp.Data)
+	}
+}
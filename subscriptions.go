@@ -0,0 +1,232 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SubscriptionStatus is the state of a subscription.
+type SubscriptionStatus string
+
+const (
+	SubscriptionActive      SubscriptionStatus = "active"
+	SubscriptionNonRenewing SubscriptionStatus = "non-renewing" // cancelled, runs until the end of the current period
+	SubscriptionAttention   SubscriptionStatus = "attention"    // the last charge failed
+	SubscriptionCompleted   SubscriptionStatus = "completed"    // the invoice limit was reached
+	SubscriptionCancelled   SubscriptionStatus = "cancelled"
+)
+
+// create subscription
+type SubscriptionInput struct {
+	Customer      string     `json:"customer" validate:"required"` // email or customer code
+	Plan          string     `json:"plan" validate:"required"`     // plan code
+	Authorization string     `json:"authorization,omitempty"`      // charge this authorization instead of the most recent one
+	StartDate     *time.Time `json:"start_date,omitempty"`         // first charge, immediately when nil
+}
+
+// list subscriptions
+type ListSubscriptions struct {
+	PageFilter
+	Customer int64 `json:"customer" schema:"customer"` // customer ID
+	Plan     int64 `json:"plan" schema:"plan"`         // plan ID
+}
+
+// SubscriptionCustomer is the subscribed customer. Paystack sends either its ID
+// alone or the whole customer object.
+type SubscriptionCustomer struct {
+	CustomerData
+}
+
+// SubscriptionPlan is the plan subscribed to. Paystack sends either its ID
+// alone or the whole plan object.
+type SubscriptionPlan struct {
+	Plan
+}
+
+// SubscriptionAuthorization is the authorization charged for a subscription.
+// Paystack sends either its ID alone or the whole authorization object.
+type SubscriptionAuthorization struct {
+	ID int64 `json:"id"`
+	AuthorizationData
+}
+
+type Subscription struct {
+	ID               int64                     `json:"id"`
+	Customer         SubscriptionCustomer      `json:"customer"`
+	Plan             SubscriptionPlan          `json:"plan"`
+	Integration      int64                     `json:"integration"`
+	Domain           string                    `json:"domain"`
+	Start            int64                     `json:"start"` // unix timestamp
+	Status           SubscriptionStatus        `json:"status"`
+	Quantity         int                       `json:"quantity"`
+	Amount           Money                     `json:"amount"`
+	SubscriptionCode string                    `json:"subscription_code"`
+	EmailToken       string                    `json:"email_token"` // needed to enable or disable the subscription
+	Authorization    SubscriptionAuthorization `json:"authorization"`
+	EasyCronID       *string                   `json:"easy_cron_id"` // Use a pointer to allow for null values
+	CronExpression   string                    `json:"cron_expression"`
+	NextPaymentDate  *time.Time                `json:"next_payment_date"` // Use a pointer to allow for null values
+	OpenInvoice      *string                   `json:"open_invoice"`      // Use a pointer to allow for null values
+	InvoiceLimit     int                       `json:"invoice_limit"`
+	PaymentsCount    int                       `json:"payments_count"`
+	CreatedAt        string                    `json:"createdAt"`
+	UpdatedAt        string                    `json:"updatedAt"`
+}
+
+type SubscriptionResponse struct {
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    Subscription `json:"data"`
+}
+
+type SubscriptionListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []Subscription  `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+type SubscriptionLinkResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Link string `json:"link"` // page where the customer can update their card
+	} `json:"data"`
+}
+
+// UnmarshalJSON accepts either a bare customer ID or a customer object.
+func (c *SubscriptionCustomer) UnmarshalJSON(data []byte) error {
+	*c = SubscriptionCustomer{}
+	return unmarshalIDOrObject(data, &c.ID, &c.CustomerData)
+}
+
+// UnmarshalJSON accepts either a bare plan ID or a plan object.
+func (pl *SubscriptionPlan) UnmarshalJSON(data []byte) error {
+	*pl = SubscriptionPlan{}
+	return unmarshalIDOrObject(data, &pl.ID, &pl.Plan)
+}
+
+// UnmarshalJSON accepts either a bare authorization ID or an authorization object.
+func (a *SubscriptionAuthorization) UnmarshalJSON(data []byte) error {
+	type alias SubscriptionAuthorization
+	*a = SubscriptionAuthorization{}
+	return unmarshalIDOrObject(data, &a.ID, (*alias)(a))
+}
+
+// UnmarshalJSON fills in the currency of Amount from the plan, when it was sent.
+func (s *Subscription) UnmarshalJSON(data []byte) error {
+	type alias Subscription
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+	s.Amount.Currency = Currency(s.Plan.Currency)
+	return nil
+}
+
+// CreateSubscription subscribes a customer with a saved authorization to a plan.
+func (p *Paystack) CreateSubscription(payload SubscriptionInput) (*SubscriptionResponse, error) {
+	return p.CreateSubscriptionContext(context.Background(), payload)
+}
+
+// CreateSubscriptionContext is like CreateSubscription but aborts the call when ctx is cancelled.
+func (p *Paystack) CreateSubscriptionContext(ctx context.Context, payload SubscriptionInput) (*SubscriptionResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	return do[SubscriptionResponse](ctx, p, "subscription.create", http.MethodPost, "/subscription", nil, payload)
+}
+
+func (p *Paystack) ListSubscriptions(filter ListSubscriptions) (*SubscriptionListResponse, error) {
+	return p.ListSubscriptionsContext(context.Background(), filter)
+}
+
+// ListSubscriptionsContext is like ListSubscriptions but aborts the call when ctx is cancelled.
+func (p *Paystack) ListSubscriptionsContext(ctx context.Context, filter ListSubscriptions) (*SubscriptionListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[SubscriptionListResponse](ctx, p, "subscription.list", http.MethodGet, "/subscription", query, nil)
+}
+
+// FetchSubscription returns a subscription by ID or subscription code.
+func (p *Paystack) FetchSubscription(idOrCode string) (*SubscriptionResponse, error) {
+	return p.FetchSubscriptionContext(context.Background(), idOrCode)
+}
+
+// FetchSubscriptionContext is like FetchSubscription but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchSubscriptionContext(ctx context.Context, idOrCode string) (*SubscriptionResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("subscription id or code is required")
+	}
+	return do[SubscriptionResponse](ctx, p, "subscription.fetch", http.MethodGet, "/subscription/"+url.PathEscape(idOrCode), nil, nil)
+}
+
+// EnableSubscription reactivates a subscription using its code and email token.
+func (p *Paystack) EnableSubscription(code, emailToken string) (*MessageResponse, error) {
+	return p.EnableSubscriptionContext(context.Background(), code, emailToken)
+}
+
+// EnableSubscriptionContext is like EnableSubscription but aborts the call when ctx is cancelled.
+func (p *Paystack) EnableSubscriptionContext(ctx context.Context, code, emailToken string) (*MessageResponse, error) {
+	return p.toggleSubscription(ctx, "enable", code, emailToken)
+}
+
+// DisableSubscription stops a subscription using its code and email token.
+func (p *Paystack) DisableSubscription(code, emailToken string) (*MessageResponse, error) {
+	return p.DisableSubscriptionContext(context.Background(), code, emailToken)
+}
+
+// DisableSubscriptionContext is like DisableSubscription but aborts the call when ctx is cancelled.
+func (p *Paystack) DisableSubscriptionContext(ctx context.Context, code, emailToken string) (*MessageResponse, error) {
+	return p.toggleSubscription(ctx, "disable", code, emailToken)
+}
+
+func (p *Paystack) toggleSubscription(ctx context.Context, action, code, emailToken string) (*MessageResponse, error) {
+	if code == "" || emailToken == "" {
+		return nil, errors.New("subscription code and email token are required")
+	}
+	requestBody := map[string]interface{}{
+		"code":  code,
+		"token": emailToken,
+	}
+	return do[MessageResponse](ctx, p, "subscription."+action, http.MethodPost, "/subscription/"+action, nil, requestBody)
+}
+
+// GenerateUpdateSubscriptionLink returns a link where the customer can change
+// the card charged for a subscription.
+func (p *Paystack) GenerateUpdateSubscriptionLink(code string) (*SubscriptionLinkResponse, error) {
+	return p.GenerateUpdateSubscriptionLinkContext(context.Background(), code)
+}
+
+// GenerateUpdateSubscriptionLinkContext is like GenerateUpdateSubscriptionLink but aborts the call when ctx is cancelled.
+func (p *Paystack) GenerateUpdateSubscriptionLinkContext(ctx context.Context, code string) (*SubscriptionLinkResponse, error) {
+	if code == "" {
+		return nil, errors.New("subscription code is required")
+	}
+	return do[SubscriptionLinkResponse](ctx, p, "subscription.manage_link", http.MethodGet, "/subscription/"+url.PathEscape(code)+"/manage/link", nil, nil)
+}
+
+// SendUpdateSubscriptionLink emails the customer the link returned by GenerateUpdateSubscriptionLink.
+func (p *Paystack) SendUpdateSubscriptionLink(code string) (*MessageResponse, error) {
+	return p.SendUpdateSubscriptionLinkContext(context.Background(), code)
+}
+
+// SendUpdateSubscriptionLinkContext is like SendUpdateSubscriptionLink but aborts the call when ctx is cancelled.
+func (p *Paystack) SendUpdateSubscriptionLinkContext(ctx context.Context, code string) (*MessageResponse, error) {
+	if code == "" {
+		return nil, errors.New("subscription code is required")
+	}
+	return do[MessageResponse](ctx, p, "subscription.manage_email", http.MethodPost, "/subscription/"+url.PathEscape(code)+"/manage/email", nil, nil)
+}
@@ -0,0 +1,73 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCreateSubscription(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/subscription",
+		`{"status":true,"message":"Subscription successfully created","data":{"customer":1173,"plan":28,"integration":100032,"domain":"test","start":1459296064,"status":"active","quantity":1,"amount":50000,"authorization":{"authorization_code":"AUTH_6tmt288t0o","bin":"408408","last4":"4081","reusable":true,"signature":"SIG_uSYN4fv1adlAuoij8QXh"},"subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","id":9,"createdAt":"2016-03-30T00:01:04.687Z"}}`,
+		&received)
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := p.CreateSubscription(SubscriptionInput{Customer: "CUS_xnxdt6s1zg1f4nx", Plan: "PLN_gx2wn530m0i3w3m", StartDate: &start})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["start_date"] != "2024-06-01T00:00:00Z" || received["plan"] != "PLN_gx2wn530m0i3w3m" {
+		t.Errorf("Unexpected body: %v", received)
+	}
+	data := resp.Data
+	if data.Customer.ID != 1173 || data.Plan.ID != 28 || data.Authorization.AuthorizationCode != "AUTH_6tmt288t0o" {
+		t.Errorf("Unexpected subscription: %+v", data)
+	}
+}
+
+func TestFetchSubscription(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/subscription/SUB_vsyqdmlzble3uii",
+		`{"status":true,"message":"Subscription retrieved successfully","data":{"invoices":[],"customer":{"first_name":"BoJack","last_name":"Horseman","email":"bojack@horsinaround.com","phone":"","customer_code":"CUS_xnxdt6s1zg1f4nx","id":1173},"plan":{"domain":"test","name":"Monthly retainer","plan_code":"PLN_gx2wn530m0i3w3m","interval":"monthly","amount":50000,"currency":"GHS","id":28},"authorization":1209,"status":"attention","amount":50000,"subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","next_payment_date":"2016-06-30T00:01:04.000Z","id":9}}`,
+		nil)
+
+	resp, err := p.FetchSubscription("SUB_vsyqdmlzble3uii")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	data := resp.Data
+	if data.Customer.CustomerCode != "CUS_xnxdt6s1zg1f4nx" || data.Plan.Interval != IntervalMonthly || data.Authorization.ID != 1209 {
+		t.Errorf("Unexpected subscription: %+v", data)
+	}
+	if data.Status != SubscriptionAttention || data.Amount != NewMoney(50000, GHS) || data.NextPaymentDate == nil {
+		t.Errorf("Unexpected subscription: %+v", data)
+	}
+}
+
+func TestDisableSubscription(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/subscription/disable", `{"status":true,"message":"Subscription disabled successfully"}`, &received)
+
+	if _, err := p.DisableSubscription("SUB_vsyqdmlzble3uii", "d7gofp6yppn3qz7"); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["code"] != "SUB_vsyqdmlzble3uii" || received["token"] != "d7gofp6yppn3qz7" {
+		t.Errorf("Unexpected body: %v", received)
+	}
+	if _, err := p.EnableSubscription("SUB_vsyqdmlzble3uii", ""); err == nil {
+		t.Error("Expected an error without an email token")
+	}
+}
+
+func TestGenerateUpdateSubscriptionLink(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/subscription/SUB_vsyqdmlzble3uii/manage/link",
+		`{"status":true,"message":"Link generated","data":{"link":"https://paystack.com/manage/subscriptions/qlgwhpyq1ts9nsw?subscription_token=uqbanusldqbek"}}`,
+		nil)
+
+	resp, err := p.GenerateUpdateSubscriptionLink("SUB_vsyqdmlzble3uii")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Link == "" {
+		t.Error("Expected a link")
+	}
+}
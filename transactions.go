@@ -0,0 +1,135 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// transaction totals
+type TransactionTotalsFilter struct {
+	PageFilter
+}
+
+// export transactions
+type ExportTransactions struct {
+	PageFilter
+	Customer    string            `json:"customer" schema:"customer"` // customer ID
+	Status      TransactionStatus `json:"status" schema:"status"`
+	Currency    string            `json:"currency" schema:"currency"`
+	Amount      Money             `json:"amount" schema:"amount"`
+	Settled     *bool             `json:"settled" schema:"settled"`
+	Settlement  string            `json:"settlement" schema:"settlement"` // settlement ID
+	PaymentPage string            `json:"payment_page" schema:"payment_page"`
+}
+
+type TimelineResponse struct {
+	Status  bool    `json:"status"`
+	Message string  `json:"message"`
+	Data    LogData `json:"data"`
+}
+
+// CurrencyAmount is a total in a single currency.
+type CurrencyAmount struct {
+	Currency string `json:"currency"`
+	Amount   Money  `json:"amount"`
+}
+
+type TransactionTotalsResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		TotalTransactions          int              `json:"total_transactions"`
+		UniqueCustomers            int              `json:"unique_customers"`
+		TotalVolume                int64            `json:"total_volume"` // sum over all currencies
+		TotalVolumeByCurrency      []CurrencyAmount `json:"total_volume_by_currency"`
+		PendingTransfers           int64            `json:"pending_transfers"`
+		PendingTransfersByCurrency []CurrencyAmount `json:"pending_transfers_by_currency"`
+	} `json:"data"`
+}
+
+type ExportResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		Path      string `json:"path"` // download URL of the CSV file
+		ExpiresAt string `json:"expiresAt"`
+	} `json:"data"`
+}
+
+// UnmarshalJSON fills in the currency of Amount.
+func (c *CurrencyAmount) UnmarshalJSON(data []byte) error {
+	type alias CurrencyAmount
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+	c.Amount.Currency = Currency(c.Currency)
+	return nil
+}
+
+// FetchTransaction returns a transaction by its numeric ID.
+func (p *Paystack) FetchTransaction(id int64) (*GetResponseData, error) {
+	return p.FetchTransactionContext(context.Background(), id)
+}
+
+// FetchTransactionContext is like FetchTransaction but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchTransactionContext(ctx context.Context, id int64) (*GetResponseData, error) {
+	if id <= 0 {
+		return nil, errors.New("transaction id must be greater than zero")
+	}
+	return do[GetResponseData](ctx, p, "transaction.fetch", http.MethodGet, "/transaction/"+strconv.FormatInt(id, 10), nil, nil)
+}
+
+// TransactionTimeline returns the checkout steps of a transaction by ID or reference.
+func (p *Paystack) TransactionTimeline(idOrReference string) (*TimelineResponse, error) {
+	return p.TransactionTimelineContext(context.Background(), idOrReference)
+}
+
+// TransactionTimelineContext is like TransactionTimeline but aborts the call when ctx is cancelled.
+func (p *Paystack) TransactionTimelineContext(ctx context.Context, idOrReference string) (*TimelineResponse, error) {
+	if idOrReference == "" {
+		return nil, errors.New("transaction id or reference is required")
+	}
+	return do[TimelineResponse](ctx, p, "transaction.timeline", http.MethodGet, "/transaction/timeline/"+url.PathEscape(idOrReference), nil, nil)
+}
+
+// TransactionTotals returns the volume received in the filter's date range.
+func (p *Paystack) TransactionTotals(filter TransactionTotalsFilter) (*TransactionTotalsResponse, error) {
+	return p.TransactionTotalsContext(context.Background(), filter)
+}
+
+// TransactionTotalsContext is like TransactionTotals but aborts the call when ctx is cancelled.
+func (p *Paystack) TransactionTotalsContext(ctx context.Context, filter TransactionTotalsFilter) (*TransactionTotalsResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[TransactionTotalsResponse](ctx, p, "transaction.totals", http.MethodGet, "/transaction/totals", query, nil)
+}
+
+// ExportTransactions generates a CSV export and returns the URL to download it from.
+func (p *Paystack) ExportTransactions(filter ExportTransactions) (*ExportResponse, error) {
+	return p.ExportTransactionsContext(context.Background(), filter)
+}
+
+// ExportTransactionsContext is like ExportTransactions but aborts the call when ctx is cancelled.
+func (p *Paystack) ExportTransactionsContext(ctx context.Context, filter ExportTransactions) (*ExportResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[ExportResponse](ctx, p, "transaction.export", http.MethodGet, "/transaction/export", query, nil)
+}
@@ -0,0 +1,75 @@
+package paystack
+
+import (
+	"testing"
+	"time"
+)
+
+func TestListTransactionsDateRange(t *testing.T) {
+	p, last := stubClient(t, "GET", "/transaction",
+		`{"status":true,"message":"Transactions retrieved","data":[],"meta":{"total":0,"perPage":10,"page":1,"pageCount":0}}`,
+		nil)
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	_, err := p.ListTransactions(ListTransactions{PerPage: 10, Page: 1, Status: Success, From: &from, To: &to})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	query := last.URL.Query()
+	if query.Get("from") != "2024-02-01T00:00:00Z" || query.Get("to") != "2024-02-29T00:00:00Z" || query.Get("status") != "success" {
+		t.Errorf("Unexpected query: %s", last.URL.RawQuery)
+	}
+
+	if _, err := p.ListTransactions(ListTransactions{PerPage: 10, Page: 1, From: &to, To: &from}); err == nil {
+		t.Errorf("Expected a range ending before it starts to be rejected")
+	}
+	if _, err := p.TransactionTotals(TransactionTotalsFilter{PageFilter{From: &to, To: &from}}); err == nil {
+		t.Errorf("Expected a range ending before it starts to be rejected")
+	}
+}
+
+func TestTransactionTotals(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/transaction/totals",
+		`{"status":true,"message":"Transaction totals","data":{"total_transactions":10,"unique_customers":3,"total_volume":14000,"total_volume_by_currency":[{"currency":"NGN","amount":14000}],"pending_transfers":24000,"pending_transfers_by_currency":[{"currency":"GHS","amount":24000}]}}`,
+		nil)
+
+	resp, err := p.TransactionTotals(TransactionTotalsFilter{})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.TotalTransactions != 10 || resp.Data.TotalVolumeByCurrency[0].Amount != NewMoney(14000, NGN) {
+		t.Errorf("Unexpected totals: %+v", resp.Data)
+	}
+	if resp.Data.PendingTransfersByCurrency[0].Amount != NewMoney(24000, GHS) {
+		t.Errorf("Unexpected pending transfers: %+v", resp.Data.PendingTransfersByCurrency)
+	}
+}
+
+func TestTransactionTimeline(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/transaction/timeline/ref_1",
+		`{"status":true,"message":"Timeline retrieved","data":{"time_spent":9,"attempts":1,"success":true,"history":[{"type":"success","message":"Successfully paid","time":8}]}}`,
+		nil)
+
+	resp, err := p.TransactionTimeline("ref_1")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !resp.Data.Success || len(resp.Data.History) != 1 || resp.Data.History[0].Type != "success" {
+		t.Errorf("Unexpected timeline: %+v", resp.Data)
+	}
+}
+
+func TestFetchTransaction(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/transaction/4099260516",
+		`{"status":true,"message":"Transaction retrieved","data":{"id":4099260516,"reference":"re4lyvq3s3","currency":"NGN","amount":40333}}`,
+		nil)
+
+	resp, err := p.FetchTransaction(4099260516)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Reference != "re4lyvq3s3" || resp.Data.Amount != NewMoney(40333, NGN) {
+		t.Errorf("Unexpected transaction: %+v", resp.Data)
+	}
+}
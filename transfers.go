@@ -0,0 +1,298 @@
+package paystack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrOutcomeUnknown is returned by Transfer when the call failed without a
+// clear answer and the transfer could not be looked up by its reference either.
+// The transfer may or may not exist: verify the reference before sending it again.
+var ErrOutcomeUnknown = errors.New("transfer outcome unknown")
+
+// ErrReferenceConflict is returned by Transfer when its reference, or the one
+// derived from its idempotency key, already belongs to a different transfer.
+var ErrReferenceConflict = errors.New("transfer reference already used for a different transfer")
+
+// transferBackoff is the wait before sending again a transfer that was verified
+// not to exist, doubled on every further attempt.
+var transferBackoff = time.Second
+
+const (
+	// transferAttempts caps how often Transfer sends a transfer it verified was not created.
+	transferAttempts = 2
+	// lookupTimeout bounds the lookup of a transfer after an unclear failure.
+	lookupTimeout = 15 * time.Second
+)
+
+// one transfer of a bulk transfer
+type BulkTransferItem struct {
+	Amount    Money  `json:"amount" validate:"required,gt=0"`
+	Recipient string `json:"recipient" validate:"required"` // recipient code
+	Reference string `json:"reference,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// bulk transfer, all transfers are paid from the balance in Currency
+type BulkTransferInput struct {
+	Currency  string             `json:"currency" validate:"omitempty,len=3"`
+	Transfers []BulkTransferItem `json:"transfers" validate:"required,min=1,dive"`
+}
+
+// list transfers
+type ListTransfers struct {
+	PageFilter
+	Recipient int `json:"recipient" schema:"recipient"` // recipient ID
+}
+
+type BulkTransferResponse struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    []struct {
+		Reference    string         `json:"reference"`
+		Recipient    string         `json:"recipient"`
+		Amount       Money          `json:"amount"`
+		TransferCode string         `json:"transfer_code"`
+		Currency     string         `json:"currency"`
+		Status       TransferStatus `json:"status"`
+	} `json:"data"`
+}
+
+type TransferListResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    []TransferData  `json:"data"`
+	Meta    MetaTransaction `json:"meta"`
+}
+
+type TransferResponse struct {
+	Status  bool         `json:"status"`
+	Message string       `json:"message"`
+	Data    TransferData `json:"data"`
+}
+
+// UnmarshalJSON fills in the currency of Amount from the transfer's currency.
+func (t *TransferData) UnmarshalJSON(data []byte) error {
+	type alias TransferData
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+	t.Amount.Currency = Currency(t.Currency)
+	return nil
+}
+
+// UnmarshalJSON accepts either a bare recipient ID or a recipient object.
+func (r *TransferRecipient) UnmarshalJSON(data []byte) error {
+	var id int64
+	*r = TransferRecipient{}
+	if err := unmarshalIDOrObject(data, &id, &r.RecipientData); err != nil {
+		return err
+	}
+	if id != 0 {
+		r.ID = int(id)
+	}
+	return nil
+}
+
+// BulkTransfer initiates several transfers in one call. References left empty are generated.
+func (p *Paystack) BulkTransfer(payload BulkTransferInput) (*BulkTransferResponse, error) {
+	return p.BulkTransferContext(context.Background(), payload)
+}
+
+// BulkTransferContext is like BulkTransfer but aborts the call when ctx is cancelled.
+func (p *Paystack) BulkTransferContext(ctx context.Context, payload BulkTransferInput) (*BulkTransferResponse, error) {
+	//validate arguments
+	err := Validate(payload)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+
+	// every transfer must be in the batch currency
+	transfers := make([]BulkTransferItem, len(payload.Transfers))
+	for i, transfer := range payload.Transfers {
+		if payload.Currency, err = resolveCurrency(payload.Currency, transfer.Amount); err != nil {
+			return nil, fmt.Errorf("transfer %d: %w", i, err)
+		}
+		if transfer.Reference == "" {
+			transfer.Reference = uuid.New().String()
+		}
+		transfers[i] = transfer
+	}
+	if payload.Currency == "" {
+		payload.Currency = string(NGN)
+	}
+
+	requestBody := map[string]interface{}{
+		"source":    "balance", //only balance is allowed for now
+		"currency":  payload.Currency,
+		"transfers": transfers,
+	}
+	response, err := do[BulkTransferResponse](ctx, p, "transfer.bulk", http.MethodPost, "/transfer/bulk", nil, requestBody)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Data {
+		response.Data[i].Amount.Currency = Currency(response.Data[i].Currency)
+	}
+	return response, nil
+}
+
+func (p *Paystack) ListTransfers(filter ListTransfers) (*TransferListResponse, error) {
+	return p.ListTransfersContext(context.Background(), filter)
+}
+
+// ListTransfersContext is like ListTransfers but aborts the call when ctx is cancelled.
+func (p *Paystack) ListTransfersContext(ctx context.Context, filter ListTransfers) (*TransferListResponse, error) {
+	//validate arguments
+	err := Validate(filter)
+	if err != nil {
+		return nil, errors.New("Error validating  arguments: " + err.Error())
+	}
+	query, err := encodeQuery(filter)
+	if err != nil {
+		return nil, errors.New("Error encoding filtered data: " + err.Error())
+	}
+	return do[TransferListResponse](ctx, p, "transfer.list", http.MethodGet, "/transfer", query, nil)
+}
+
+// FetchTransfer returns a transfer by ID or transfer code.
+func (p *Paystack) FetchTransfer(idOrCode string) (*TransferResponse, error) {
+	return p.FetchTransferContext(context.Background(), idOrCode)
+}
+
+// FetchTransferContext is like FetchTransfer but aborts the call when ctx is cancelled.
+func (p *Paystack) FetchTransferContext(ctx context.Context, idOrCode string) (*TransferResponse, error) {
+	if idOrCode == "" {
+		return nil, errors.New("transfer id or code is required")
+	}
+	return do[TransferResponse](ctx, p, "transfer.fetch", http.MethodGet, "/transfer/"+url.PathEscape(idOrCode), nil, nil)
+}
+
+// VerifyTransfer returns a transfer by the reference it was initiated with.
+func (p *Paystack) VerifyTransfer(reference string) (*TransferResponse, error) {
+	return p.VerifyTransferContext(context.Background(), reference)
+}
+
+// VerifyTransferContext is like VerifyTransfer but aborts the call when ctx is cancelled.
+func (p *Paystack) VerifyTransferContext(ctx context.Context, reference string) (*TransferResponse, error) {
+	if reference == "" {
+		return nil, errors.New("transfer reference is required")
+	}
+	return do[TransferResponse](ctx, p, "transfer.verify", http.MethodGet, "/transfer/verify/"+url.PathEscape(reference), nil, nil)
+}
+
+// ResendTransferOTP sends the OTP of a transfer awaiting confirmation again.
+func (p *Paystack) ResendTransferOTP(transferCode string) (*MessageResponse, error) {
+	return p.ResendTransferOTPContext(context.Background(), transferCode)
+}
+
+// ResendTransferOTPContext is like ResendTransferOTP but aborts the call when ctx is cancelled.
+func (p *Paystack) ResendTransferOTPContext(ctx context.Context, transferCode string) (*MessageResponse, error) {
+	if transferCode == "" {
+		return nil, errors.New("transfer code is required")
+	}
+	requestBody := map[string]interface{}{
+		"transfer_code": transferCode,
+		"reason":        "transfer",
+	}
+	return do[MessageResponse](ctx, p, "transfer.resend_otp", http.MethodPost, "/transfer/resend_otp", nil, requestBody)
+}
+
+// DisableTransferOTP requests an OTP to turn off OTP confirmation of transfers.
+// Finish with FinalizeDisableTransferOTP.
+func (p *Paystack) DisableTransferOTP() (*MessageResponse, error) {
+	return p.DisableTransferOTPContext(context.Background())
+}
+
+// DisableTransferOTPContext is like DisableTransferOTP but aborts the call when ctx is cancelled.
+func (p *Paystack) DisableTransferOTPContext(ctx context.Context) (*MessageResponse, error) {
+	return do[MessageResponse](ctx, p, "transfer.disable_otp", http.MethodPost, "/transfer/disable_otp", nil, nil)
+}
+
+// FinalizeDisableTransferOTP turns off OTP confirmation with the OTP sent by DisableTransferOTP.
+func (p *Paystack) FinalizeDisableTransferOTP(otp string) (*MessageResponse, error) {
+	return p.FinalizeDisableTransferOTPContext(context.Background(), otp)
+}
+
+// FinalizeDisableTransferOTPContext is like FinalizeDisableTransferOTP but aborts the call when ctx is cancelled.
+func (p *Paystack) FinalizeDisableTransferOTPContext(ctx context.Context, otp string) (*MessageResponse, error) {
+	if otp == "" {
+		return nil, errors.New("otp is required")
+	}
+	requestBody := map[string]interface{}{
+		"otp": otp,
+	}
+	return do[MessageResponse](ctx, p, "transfer.disable_otp_finalize", http.MethodPost, "/transfer/disable_otp_finalize", nil, requestBody)
+}
+
+// EnableTransferOTP turns OTP confirmation of transfers back on.
+func (p *Paystack) EnableTransferOTP() (*MessageResponse, error) {
+	return p.EnableTransferOTPContext(context.Background())
+}
+
+// EnableTransferOTPContext is like EnableTransferOTP but aborts the call when ctx is cancelled.
+func (p *Paystack) EnableTransferOTPContext(ctx context.Context) (*MessageResponse, error) {
+	return do[MessageResponse](ctx, p, "transfer.enable_otp", http.MethodPost, "/transfer/enable_otp", nil, nil)
+}
+
+// TransferReference derives the transfer reference for an idempotency key, so
+// every attempt at the same logical transfer uses the same reference.
+func TransferReference(idempotencyKey string) string {
+	sum := sha256.Sum256([]byte(idempotencyKey))
+	return "idem_" + hex.EncodeToString(sum[:20])
+}
+
+// isAmbiguous reports whether err leaves it unclear if Paystack acted on the call.
+func isAmbiguous(err error) bool {
+	if apiErr, ok := asAPIError(err); ok {
+		// a 429 was turned away before reaching the transfer, only 5xx leave it unclear
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	// network errors, timeouts and unreadable responses
+	return true
+}
+
+// lookupTransfer verifies the transfer with reference, even when ctx already
+// expired as that is usually what made the outcome unclear.
+func (p *Paystack) lookupTransfer(ctx context.Context, reference string) (*TransferResponse, error) {
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, lookupTimeout)
+	defer cancel()
+	return p.VerifyTransferContext(ctx, reference)
+}
+
+// detachedContext keeps the values of a context but not its deadline or cancellation.
+type detachedContext struct{ context.Context }
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+// matchesTransfer reports whether transfer is the one payload describes. The
+// recipient can only be compared when Paystack sent the whole recipient object.
+func matchesTransfer(transfer TransferData, payload TransferInput) bool {
+	if transfer.Amount.Amount != payload.Amount.Amount || transfer.Currency != payload.Currency {
+		return false
+	}
+	return transfer.Recipient.RecipientCode == "" || transfer.Recipient.RecipientCode == payload.Recipient
+}
+
+// wait sleeps for d or until ctx is done.
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,239 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBulkTransfer(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/transfer/bulk",
+		`{"status":true,"message":"2 transfers queued.","data":[{"reference":"acv_1","recipient":"RCP_db342dvqvz9qcrn","amount":50000,"transfer_code":"TRF_xsn8nmnfbc1vlpl","currency":"NGN","status":"received"},{"reference":"acv_2","recipient":"RCP_db342dvqvz9qcrn","amount":50000,"transfer_code":"TRF_1dq0wv1h2nbvpp1","currency":"NGN","status":"received"}]}`,
+		&received)
+
+	resp, err := p.BulkTransfer(BulkTransferInput{Transfers: []BulkTransferItem{
+		{Amount: NewMoney(50000, NGN), Recipient: "RCP_db342dvqvz9qcrn", Reference: "acv_1"},
+		{Amount: NewMoney(50000, NGN), Recipient: "RCP_db342dvqvz9qcrn"},
+	}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	transfers := received["transfers"].([]interface{})
+	if received["currency"] != "NGN" || received["source"] != "balance" || len(transfers) != 2 {
+		t.Fatalf("Unexpected body: %v", received)
+	}
+	if transfers[0].(map[string]interface{})["reference"] != "acv_1" || transfers[1].(map[string]interface{})["reference"] == "" {
+		t.Errorf("Expected references to be kept or generated, but got: %v", transfers)
+	}
+	if resp.Data[1].Status != TransferReceived || resp.Data[1].Amount != NewMoney(50000, NGN) {
+		t.Errorf("Unexpected transfer: %+v", resp.Data[1])
+	}
+}
+
+func TestBulkTransferCurrencyMismatch(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	_, err := p.BulkTransfer(BulkTransferInput{Currency: "NGN", Transfers: []BulkTransferItem{
+		{Amount: NewMoney(50000, GHS), Recipient: "RCP_db342dvqvz9qcrn"},
+	}})
+	if err == nil {
+		t.Fatal("Expected a currency mismatch error, but got none")
+	}
+}
+
+func TestListTransfers(t *testing.T) {
+	p, last := stubClient(t, "GET", "/transfer",
+		`{"status":true,"message":"Transfers retrieved","data":[{"integration":100073,"recipient":{"domain":"test","type":"nuban","currency":"NGN","name":"Flesh","recipient_code":"RCP_bgpp3lr4o4fjuw8","id":1242},"domain":"test","amount":4400,"currency":"NGN","source":"balance","reason":"Here's your money","status":"success","transfer_code":"TRF_2x5j67tnnw1t98k","id":14938}],"meta":{"total":1,"perPage":50,"page":1,"pageCount":1}}`,
+		nil)
+
+	resp, err := p.ListTransfers(ListTransfers{Recipient: 1242})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if last.URL.Query().Get("recipient") != "1242" {
+		t.Errorf("Unexpected query: %s", last.URL.RawQuery)
+	}
+	transfer := resp.Data[0]
+	if transfer.Recipient.RecipientCode != "RCP_bgpp3lr4o4fjuw8" || transfer.Status != TransferSuccess || transfer.Amount != NewMoney(4400, NGN) {
+		t.Errorf("Unexpected transfer: %+v", transfer)
+	}
+}
+
+func TestFetchTransfer(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/transfer/TRF_2x5j67tnnw1t98k",
+		`{"status":true,"message":"Transfer retrieved","data":{"recipient":1242,"amount":4400,"currency":"NGN","reference":"ref_demo","status":"pending","transfer_code":"TRF_2x5j67tnnw1t98k","id":14938}}`,
+		nil)
+
+	resp, err := p.FetchTransfer("TRF_2x5j67tnnw1t98k")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Recipient.ID != 1242 || resp.Data.Status != TransferPending {
+		t.Errorf("Unexpected transfer: %+v", resp.Data)
+	}
+}
+
+func TestVerifyTransfer(t *testing.T) {
+	p, _ := stubClient(t, "GET", "/transfer/verify/ref_demo",
+		`{"status":true,"message":"Transfer retrieved","data":{"recipient":{"recipient_code":"RCP_bgpp3lr4o4fjuw8","id":1242},"amount":4400,"currency":"GHS","reference":"ref_demo","status":"reversed","id":14938}}`,
+		nil)
+
+	resp, err := p.VerifyTransfer("ref_demo")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if resp.Data.Status != TransferReversed || resp.Data.Amount != NewMoney(4400, GHS) {
+		t.Errorf("Unexpected transfer: %+v", resp.Data)
+	}
+	if _, err := p.VerifyTransfer(""); err == nil {
+		t.Error("Expected an error for an empty reference")
+	}
+}
+
+func TestTransferOTP(t *testing.T) {
+	var received map[string]interface{}
+	p, _ := stubClient(t, "POST", "/transfer/resend_otp", `{"status":true,"message":"OTP has been resent"}`, &received)
+	if _, err := p.ResendTransferOTP("TRF_vsyqdmlzble3uii"); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["transfer_code"] != "TRF_vsyqdmlzble3uii" || received["reason"] != "transfer" {
+		t.Errorf("Unexpected body: %v", received)
+	}
+
+	p, _ = stubClient(t, "POST", "/transfer/disable_otp_finalize", `{"status":true,"message":"OTP requirement for transfers has been disabled"}`, &received)
+	resp, err := p.FinalizeDisableTransferOTP("928783")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if received["otp"] != "928783" || !resp.Status {
+		t.Errorf("Unexpected body: %v", received)
+	}
+}
+
+// transferServer answers POST /transfer and GET /transfer/verify/ with the
+// given status codes in turn and counts the calls to each.
+func transferServer(t *testing.T, initiate, verify []int) (*Paystack, *[2]int, *map[string]interface{}) {
+	t.Helper()
+	var calls [2]int
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		statuses, i := initiate, 0
+		if r.Method == http.MethodGet {
+			statuses, i = verify, 1
+		} else {
+			json.NewDecoder(r.Body).Decode(&received)
+		}
+		status := statuses[calls[i]]
+		calls[i]++
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			w.Write([]byte(`{"status":false,"message":"` + http.StatusText(status) + `"}`))
+			return
+		}
+		w.Write([]byte(`{"status":true,"message":"Transfer has been queued","data":{"reference":"payout_1","amount":50000,"currency":"NGN","status":"pending","transfer_code":"TRF_1ptvuv321ahaa7q"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return NewPaystackClient("api-key", WithBaseURL(server.URL)), &calls, &received
+}
+
+var idempotentTransfer = TransferInput{
+	Amount:         NewMoney(50000, NGN),
+	Recipient:      "RCP_gx2wn530m0i3w3m",
+	Reason:         "March salary",
+	IdempotencyKey: "salary-2024-03-emp-42",
+}
+
+func TestTransferRequiresReference(t *testing.T) {
+	p := NewPaystackClient("api-key")
+	input := idempotentTransfer
+	input.IdempotencyKey = ""
+	if _, err := p.Transfer(input); err == nil {
+		t.Fatal("Expected an error without a reference or idempotency key")
+	}
+}
+
+func TestTransferIdempotencyKey(t *testing.T) {
+	p, _, received := transferServer(t, []int{200}, nil)
+	if _, err := p.Transfer(idempotentTransfer); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	reference := (*received)["reference"]
+	if reference != TransferReference("salary-2024-03-emp-42") || reference == TransferReference("salary-2024-04-emp-42") {
+		t.Errorf("Expected a reference derived from the key, but got: %v", reference)
+	}
+}
+
+func TestTransferAmbiguousOutcome(t *testing.T) {
+	tests := []struct {
+		name             string
+		initiate, verify []int
+		wantCalls        [2]int
+		wantErr          error
+	}{
+		{"created", []int{502}, []int{200}, [2]int{1, 1}, nil},
+		{"not created", []int{502, 200}, []int{404}, [2]int{2, 1}, nil},
+		{"unknown", []int{502}, []int{503}, [2]int{1, 1}, ErrOutcomeUnknown},
+		{"rejected", []int{400}, nil, [2]int{1, 0}, nil},
+		{"rate limited", []int{429}, nil, [2]int{1, 0}, nil},
+	}
+	defer func(backoff time.Duration) { transferBackoff = backoff }(transferBackoff)
+	transferBackoff = time.Millisecond
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, calls, _ := transferServer(t, test.initiate, test.verify)
+			resp, err := p.Transfer(idempotentTransfer)
+			if *calls != test.wantCalls {
+				t.Errorf("Expected %v calls, but got: %v", test.wantCalls, *calls)
+			}
+			switch {
+			case test.wantErr != nil:
+				if !errors.Is(err, test.wantErr) || !IsRetryable(err) {
+					t.Errorf("Expected %v wrapping the retryable failure, but got: %v", test.wantErr, err)
+				}
+			case err == nil:
+				if resp.Data.TransferCode != "TRF_1ptvuv321ahaa7q" {
+					t.Errorf("Unexpected transfer: %+v", resp.Data)
+				}
+			default:
+				// rejected outright, no lookup and no outcome to resolve
+				apiErr, ok := asAPIError(err)
+				if !ok || errors.Is(err, ErrOutcomeUnknown) || apiErr.StatusCode != test.initiate[len(test.initiate)-1] {
+					t.Errorf("Expected a plain API error, but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestTransferRecipientDecoding(t *testing.T) {
+	for _, recipient := range []string{`1242`, `"1242"`, `{"recipient_code":"RCP_bgpp3lr4o4fjuw8","id":1242}`} {
+		var transfer TransferData
+		if err := json.Unmarshal([]byte(`{"recipient":`+recipient+`}`), &transfer); err != nil {
+			t.Fatalf("Expected %s to decode, but got: %v", recipient, err)
+		}
+		if transfer.Recipient.ID != 1242 {
+			t.Errorf("Expected recipient 1242 from %s, but got: %+v", recipient, transfer.Recipient)
+		}
+	}
+}
+
+func TestTransferReferenceConflict(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		// an earlier transfer made with the same idempotency key
+		w.Write([]byte(`{"status":true,"message":"Transfer retrieved","data":{"reference":"payout_1","amount":90000,"currency":"NGN","status":"success","recipient":{"recipient_code":"RCP_gx2wn530m0i3w3m"}}}`))
+	}))
+	defer server.Close()
+
+	p := NewPaystackClient("api-key", WithBaseURL(server.URL))
+	if _, err := p.Transfer(idempotentTransfer); !errors.Is(err, ErrReferenceConflict) {
+		t.Errorf("Expected ErrReferenceConflict, but got: %v", err)
+	}
+}
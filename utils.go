@@ -1,27 +1,47 @@
 package paystack
 
 import (
-	"reflect"
-	"strings"
-	"strconv"
-	"fmt"
-	"net/url"
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/go-playground/validator/v10"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 )
 
 var validate *validator.Validate
 
 func isValidTimestamp(fl validator.FieldLevel) bool {
+	if value, ok := fl.Field().Interface().(time.Time); ok {
+		return !value.IsZero()
+	}
 	value := fl.Field().String()
 	_, err := time.Parse(time.RFC3339, value)
 	return err == nil
 }
 
+// validateDateRange rejects filters whose To date is before their From date.
+func validateDateRange(sl validator.StructLevel) {
+	from, _ := sl.Current().FieldByName("From").Interface().(*time.Time)
+	to, _ := sl.Current().FieldByName("To").Interface().(*time.Time)
+	if from != nil && to != nil && to.Before(*from) {
+		sl.ReportError(to, "To", "To", "after_from", "")
+	}
+}
+
 func init() {
 	validate = validator.New()
 	validate.RegisterValidation("timestamp", isValidTimestamp)
+	validate.RegisterStructValidation(validateDateRange, ListTransactions{}, PageFilter{})
+	validate.RegisterStructValidation(validateRecipient, AccountDetails{})
+	// validate Money fields by their minor unit amount, e.g. `validate:"required,gt=0"`
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Interface().(Money).Amount
+	}, Money{})
 }
 
 // validate struct
@@ -100,6 +120,11 @@ func (lt *ListTransactions) FilterFields() map[string]interface{} {
 			continue
 		}
 
+		// Check if the field is an amount and if it's zero
+		if money, ok := field.Interface().(Money); ok && money.IsZero() {
+			continue
+		}
+
 		// Add the field to the filtered map using the JSON key
 		filtered[jsonKey] = field.Interface()
 	}
@@ -107,34 +132,152 @@ func (lt *ListTransactions) FilterFields() map[string]interface{} {
 	return filtered
 }
 
+// toFloat converts any Go numeric value to a float64.
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// MarshalJSON sends Metadata as the stringified JSON object Paystack expects.
+func (in InitializeInput) MarshalJSON() ([]byte, error) {
+	type alias InitializeInput
+	payload := struct {
+		alias
+		Metadata          string `json:"metadata,omitempty"`
+		TransactionCharge *Money `json:"transaction_charge,omitempty"`
+	}{alias: alias(in)}
+
+	if payload.Currency == "" {
+		payload.Currency = string(in.Amount.Currency)
+	}
+	if !in.TransactionCharge.IsZero() {
+		payload.TransactionCharge = &in.TransactionCharge
+	}
 
-// encode 
+	if len(in.Metadata) > 0 {
+		metadata, err := json.Marshal(in.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		payload.Metadata = string(metadata)
+	}
+	return json.Marshal(payload)
+}
 
-func encodeFilteredFields(filtered map[string]interface{}) (string, error) {
+// UnmarshalJSON fills in the currency of the amounts from the transaction's currency.
+func (t *TransactionData) UnmarshalJSON(data []byte) error {
+	type alias TransactionData
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+	currency := Currency(t.Currency)
+	t.Amount.Currency = currency
+	t.Fees.Currency = currency
+	t.RequestedAmount.Currency = currency
+	return nil
+}
+
+// UnmarshalJSON fills in the currency of the amounts from the transaction's currency.
+func (t *ListedTransaction) UnmarshalJSON(data []byte) error {
+	type alias ListedTransaction
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+	currency := Currency(t.Currency)
+	t.Amount.Currency = currency
+	t.Fees.Currency = currency
+	t.RequestedAmount.Currency = currency
+	return nil
+}
+
+// encodeQuery turns a filter struct into query parameters named after its json
+// tags, leaving out nil pointers and zero values.
+func encodeQuery(filter interface{}) (url.Values, error) {
+	inputValue := reflect.Indirect(reflect.ValueOf(filter))
+	inputType := inputValue.Type()
 	queryValues := url.Values{}
 
-	for key, value := range filtered {
-		// Convert the value to a string
-		var strValue string
-		switch v := value.(type) {
-		case *bool:
-			if v != nil {
-				strValue = strconv.FormatBool(*v)
+	for i := 0; i < inputType.NumField(); i++ {
+		field := inputValue.Field(i)
+		fieldType := inputType.Field(i)
+
+		// Flatten embedded filters such as PageFilter
+		if fieldType.Anonymous && field.Kind() == reflect.Struct {
+			embedded, err := encodeQuery(field.Interface())
+			if err != nil {
+				return nil, err
 			}
-		case string:
-			strValue = v
-		case int:
-			strValue = strconv.Itoa(v)
-		// Add other types as needed
-		default:
-			return "", fmt.Errorf("unsupported type for key %s: %T", key, v)
+			for key, values := range embedded {
+				queryValues[key] = values
+			}
+			continue
 		}
 
-		// Add the key-value pair to the query values
-		if strValue != "" {
-			queryValues.Add(key, strValue)
+		key := strings.Split(fieldType.Tag.Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+
+		// Check if the field is a pointer and if it's nil, a set pointer is
+		// always sent so that e.g. an explicit false survives
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		} else if field.IsZero() {
+			continue
+		}
+
+		switch v := field.Interface().(type) {
+		case time.Time:
+			queryValues.Set(key, v.Format(time.RFC3339))
+		case Money:
+			queryValues.Set(key, strconv.FormatInt(v.Amount, 10))
+		default:
+			switch field.Kind() {
+			case reflect.String:
+				queryValues.Set(key, field.String())
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				queryValues.Set(key, strconv.FormatInt(field.Int(), 10))
+			case reflect.Bool:
+				queryValues.Set(key, strconv.FormatBool(field.Bool()))
+			default:
+				return nil, fmt.Errorf("unsupported type for key %s: %s", key, field.Type())
+			}
 		}
 	}
 
-	return queryValues.Encode(), nil
-}
\ No newline at end of file
+	return queryValues, nil
+}
+
+// unmarshalIDOrObject decodes data, which Paystack sends either as a bare ID
+// (possibly quoted) or as an object, into id or object respectively.
+func unmarshalIDOrObject(data []byte, id *int64, object interface{}) error {
+	if parsed, err := strconv.ParseInt(string(bytes.Trim(data, `"`)), 10, 64); err == nil {
+		*id = parsed
+		return nil
+	}
+	return json.Unmarshal(data, object)
+}
@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+// EventType is the name of a webhook event.
+type EventType string
+
+// Supported events and the type stored in Event.Data for each of them.
+const (
+	ChargeSuccess        EventType = "charge.success"         // *paystack.TransactionData
+	TransferSuccess      EventType = "transfer.success"       // *Transfer
+	TransferFailed       EventType = "transfer.failed"        // *Transfer
+	TransferReversed     EventType = "transfer.reversed"      // *Transfer
+	SubscriptionCreate   EventType = "subscription.create"    // *Subscription
+	SubscriptionDisable  EventType = "subscription.disable"   // *Subscription
+	SubscriptionNotRenew EventType = "subscription.not_renew" // *Subscription
+	RefundPending        EventType = "refund.pending"         // *Refund
+	RefundProcessing     EventType = "refund.processing"      // *Refund
+	RefundProcessed      EventType = "refund.processed"       // *Refund
+	RefundFailed         EventType = "refund.failed"          // *Refund
+)
+
+// eventData maps every supported event to a constructor for its data type.
+var eventData = map[EventType]func() interface{}{
+	ChargeSuccess:        func() interface{} { return new(paystack.TransactionData) },
+	TransferSuccess:      func() interface{} { return new(Transfer) },
+	TransferFailed:       func() interface{} { return new(Transfer) },
+	TransferReversed:     func() interface{} { return new(Transfer) },
+	SubscriptionCreate:   func() interface{} { return new(Subscription) },
+	SubscriptionDisable:  func() interface{} { return new(Subscription) },
+	SubscriptionNotRenew: func() interface{} { return new(Subscription) },
+	RefundPending:        func() interface{} { return new(Refund) },
+	RefundProcessing:     func() interface{} { return new(Refund) },
+	RefundProcessed:      func() interface{} { return new(Refund) },
+	RefundFailed:         func() interface{} { return new(Refund) },
+}
+
+// Transfer is the data of transfer.* events.
+type Transfer struct {
+	ID            int64                   `json:"id"`
+	Domain        string                  `json:"domain"`
+	Amount        paystack.Money          `json:"amount"`
+	Currency      string                  `json:"currency"`
+	Reference     string                  `json:"reference"`
+	Source        string                  `json:"source"`
+	SourceDetails interface{}             `json:"source_details"`
+	Reason        string                  `json:"reason"`
+	Status        paystack.TransferStatus `json:"status"`
+	Failures      interface{}             `json:"failures"`
+	TransferCode  string                  `json:"transfer_code"`
+	TitanCode     *string                 `json:"titan_code"`     // Use a pointer to allow for null values
+	TransferredAt *string                 `json:"transferred_at"` // Use a pointer to allow for null values
+	Recipient     struct {
+		Active        bool        `json:"active"`
+		Currency      string      `json:"currency"`
+		Description   string      `json:"description"`
+		Domain        string      `json:"domain"`
+		Email         *string     `json:"email"` // Use a pointer to allow for null values
+		ID            int64       `json:"id"`
+		Integration   int64       `json:"integration"`
+		Metadata      interface{} `json:"metadata"`
+		Name          string      `json:"name"`
+		RecipientCode string      `json:"recipient_code"`
+		Type          string      `json:"type"`
+		IsDeleted     bool        `json:"is_deleted"`
+		Details       struct {
+			AccountNumber string  `json:"account_number"`
+			AccountName   *string `json:"account_name"` // Use a pointer to allow for null values
+			BankCode      string  `json:"bank_code"`
+			BankName      string  `json:"bank_name"`
+		} `json:"details"`
+	} `json:"recipient"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// UnmarshalJSON fills in Amount.Currency from the currency field.
+func (t *Transfer) UnmarshalJSON(data []byte) error {
+	type alias Transfer
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+	t.Amount.Currency = paystack.Currency(t.Currency)
+	return nil
+}
+
+// Subscription is the data of subscription.* events.
+type Subscription = paystack.Subscription
+
+// Refund is the data of refund.* events.
+type Refund struct {
+	paystack.Refund
+	RefundDetails
+}
+
+// RefundDetails are the fields refund events carry beyond paystack.Refund.
+type RefundDetails struct {
+	TransactionReference string                `json:"transaction_reference"`
+	RefundReference      *string               `json:"refund_reference"` // Use a pointer to allow for null values
+	Processor            string                `json:"processor"`
+	Customer             paystack.CustomerData `json:"customer"`
+}
+
+// UnmarshalJSON decodes both the refund and the event specific details, which
+// the UnmarshalJSON promoted from paystack.Refund alone would skip.
+func (r *Refund) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.Refund); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &r.RefundDetails)
+}
@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+// DefaultMaxBodyBytes caps the size of a delivery read by Handler.
+const DefaultMaxBodyBytes = 1 << 20
+
+// HandlerFunc processes a verified event.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Handler is an http.Handler that verifies deliveries against the client's
+// secret key and dispatches them to the registered handlers.
+//
+// It answers 200 once the event was handled (or when nobody is registered for
+// it, or when Store reports it as a duplicate), 401 for bad signatures, 400 for
+// malformed payloads and 500 when a handler fails so that Paystack redelivers
+// the event later.
+type Handler struct {
+	// MaxBodyBytes caps the request body size, DefaultMaxBodyBytes when zero.
+	MaxBodyBytes int64
+	// OnError, when set, is called with every error that caused a non-200 response.
+	OnError func(r *http.Request, err error)
+	// Store, when set, is used to skip events that were already processed.
+	Store EventStore
+
+	secretKey string
+	handlers  map[EventType]HandlerFunc
+}
+
+// NewHandler creates a Handler verifying deliveries with the client's secret key.
+func NewHandler(client *paystack.Paystack) *Handler {
+	return &Handler{
+		secretKey: *client.APIKey,
+		handlers:  make(map[EventType]HandlerFunc),
+	}
+}
+
+// On registers fn for eventType, replacing any previous handler.
+func (h *Handler) On(eventType EventType, fn HandlerFunc) {
+	h.handlers[eventType] = fn
+}
+
+// OnChargeSuccess registers fn for charge.success.
+func (h *Handler) OnChargeSuccess(fn func(ctx context.Context, data paystack.TransactionData) error) {
+	h.On(ChargeSuccess, func(ctx context.Context, event *Event) error {
+		return fn(ctx, *event.Data.(*paystack.TransactionData))
+	})
+}
+
+// OnTransferSuccess registers fn for transfer.success.
+func (h *Handler) OnTransferSuccess(fn func(ctx context.Context, data Transfer) error) {
+	h.onTransfer(TransferSuccess, fn)
+}
+
+// OnTransferFailed registers fn for transfer.failed.
+func (h *Handler) OnTransferFailed(fn func(ctx context.Context, data Transfer) error) {
+	h.onTransfer(TransferFailed, fn)
+}
+
+// OnTransferReversed registers fn for transfer.reversed.
+func (h *Handler) OnTransferReversed(fn func(ctx context.Context, data Transfer) error) {
+	h.onTransfer(TransferReversed, fn)
+}
+
+func (h *Handler) onTransfer(eventType EventType, fn func(ctx context.Context, data Transfer) error) {
+	h.On(eventType, func(ctx context.Context, event *Event) error {
+		return fn(ctx, *event.Data.(*Transfer))
+	})
+}
+
+// OnSubscriptionCreate registers fn for subscription.create.
+func (h *Handler) OnSubscriptionCreate(fn func(ctx context.Context, data Subscription) error) {
+	h.onSubscription(SubscriptionCreate, fn)
+}
+
+// OnSubscriptionDisable registers fn for subscription.disable.
+func (h *Handler) OnSubscriptionDisable(fn func(ctx context.Context, data Subscription) error) {
+	h.onSubscription(SubscriptionDisable, fn)
+}
+
+// OnSubscriptionNotRenew registers fn for subscription.not_renew.
+func (h *Handler) OnSubscriptionNotRenew(fn func(ctx context.Context, data Subscription) error) {
+	h.onSubscription(SubscriptionNotRenew, fn)
+}
+
+func (h *Handler) onSubscription(eventType EventType, fn func(ctx context.Context, data Subscription) error) {
+	h.On(eventType, func(ctx context.Context, event *Event) error {
+		return fn(ctx, *event.Data.(*Subscription))
+	})
+}
+
+// OnRefundProcessed registers fn for refund.processed.
+func (h *Handler) OnRefundProcessed(fn func(ctx context.Context, data Refund) error) {
+	h.onRefund(RefundProcessed, fn)
+}
+
+// OnRefundFailed registers fn for refund.failed.
+func (h *Handler) OnRefundFailed(fn func(ctx context.Context, data Refund) error) {
+	h.onRefund(RefundFailed, fn)
+}
+
+// OnRefundPending registers fn for refund.pending.
+func (h *Handler) OnRefundPending(fn func(ctx context.Context, data Refund) error) {
+	h.onRefund(RefundPending, fn)
+}
+
+// OnRefundProcessing registers fn for refund.processing.
+func (h *Handler) OnRefundProcessing(fn func(ctx context.Context, data Refund) error) {
+	h.onRefund(RefundProcessing, fn)
+}
+
+func (h *Handler) onRefund(eventType EventType, fn func(ctx context.Context, data Refund) error) {
+	h.On(eventType, func(ctx context.Context, event *Event) error {
+		return fn(ctx, *event.Data.(*Refund))
+	})
+}
+
+// ServeHTTP verifies, parses and dispatches one delivery.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.fail(w, r, http.StatusMethodNotAllowed, errors.New("webhook: method "+r.Method+" not allowed"))
+		return
+	}
+
+	maxBytes := h.MaxBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBodyBytes
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBytes))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.As(err, new(*http.MaxBytesError)) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		h.fail(w, r, status, errors.New("webhook: error reading body: "+err.Error()))
+		return
+	}
+
+	event, err := Parse(h.secretKey, body, r.Header.Get(SignatureHeader))
+	var unknown *UnknownEventError
+	switch {
+	case errors.As(err, &unknown):
+		// nothing can handle it, acknowledge so Paystack stops redelivering
+		w.WriteHeader(http.StatusOK)
+		return
+	case errors.Is(err, ErrMissingSignature), errors.Is(err, ErrInvalidSignature):
+		h.fail(w, r, http.StatusUnauthorized, err)
+		return
+	case err != nil:
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch runs the handler registered for event, at most once per fingerprint when a Store is set.
+func (h *Handler) dispatch(ctx context.Context, event *Event) error {
+	fn, ok := h.handlers[event.Type]
+	if !ok {
+		return nil
+	}
+	if h.Store == nil {
+		return fn(ctx, event)
+	}
+
+	fingerprint := Fingerprint(event)
+	claimed, err := h.Store.Begin(ctx, fingerprint, event.Type)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		// duplicate delivery
+		return nil
+	}
+
+	procErr := fn(ctx, event)
+	if err := h.Store.Finish(ctx, fingerprint, procErr); err != nil && procErr == nil {
+		return err
+	}
+	return procErr
+}
+
+// fail reports err and answers with status.
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/iotest"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+func deliver(h http.Handler, body []byte, signature string) int {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/paystack", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, signature)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestHandlerDispatches(t *testing.T) {
+	h := NewHandler(paystack.NewPaystackClient(secret))
+	var got string
+	h.OnChargeSuccess(func(ctx context.Context, data paystack.TransactionData) error {
+		got = data.Reference
+		return nil
+	})
+
+	if code := deliver(h, chargeSuccess, Sign(secret, chargeSuccess)); code != http.StatusOK {
+		t.Errorf("Expected status 200, but got: %d", code)
+	}
+	if got != "qTPrJoy9Bx" {
+		t.Errorf("Expected handler to receive reference 'qTPrJoy9Bx', but got: %q", got)
+	}
+}
+
+func TestHandlerStatuses(t *testing.T) {
+	h := NewHandler(paystack.NewPaystackClient(secret))
+	h.OnChargeSuccess(func(ctx context.Context, data paystack.TransactionData) error {
+		return errors.New("database down")
+	})
+
+	unknown := []byte(`{"event":"invoice.create","data":{}}`)
+	cases := []struct {
+		name      string
+		body      []byte
+		signature string
+		want      int
+	}{
+		{"handler failure", chargeSuccess, Sign(secret, chargeSuccess), http.StatusInternalServerError},
+		{"bad signature", unknown, Sign("sk_other", unknown), http.StatusUnauthorized},
+		{"unknown event", unknown, Sign(secret, unknown), http.StatusOK},
+	}
+	for _, c := range cases {
+		if code := deliver(h, c.body, c.signature); code != c.want {
+			t.Errorf("%s: expected status %d, but got: %d", c.name, c.want, code)
+		}
+	}
+
+	h.MaxBodyBytes = 64
+	if code := deliver(h, chargeSuccess, Sign(secret, chargeSuccess)); code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected oversized body to be rejected, but got: %d", code)
+	}
+
+	// a body that fails to read for any other reason is a bad request
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/paystack", iotest.ErrReader(errors.New("connection reset")))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected unreadable body to be rejected with 400, but got: %d", rec.Code)
+	}
+}
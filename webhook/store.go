@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrEventNotFound is returned by EventStore.Status for fingerprints never seen.
+var ErrEventNotFound = errors.New("webhook: event not found")
+
+// ProcessingStatus is the state of an event in an EventStore.
+type ProcessingStatus string
+
+const (
+	StatusProcessing ProcessingStatus = "processing"
+	StatusProcessed  ProcessingStatus = "processed"
+	StatusFailed     ProcessingStatus = "failed"
+)
+
+// EventRecord is what an EventStore knows about one event.
+type EventRecord struct {
+	Fingerprint string
+	Event       EventType
+	Status      ProcessingStatus
+	Attempts    int
+	LastError   string
+	FirstSeen   time.Time
+	UpdatedAt   time.Time
+}
+
+// EventStore records processed events so redeliveries are not handled twice.
+//
+// Begin must claim a fingerprint atomically. In SQL this maps to a table with a
+// unique fingerprint column: insert a "processing" row, or update a "failed"
+// one, and report whether a row was written.
+type EventStore interface {
+	// Begin claims fingerprint for processing. It returns false when the event
+	// was already processed or is currently being processed.
+	Begin(ctx context.Context, fingerprint string, event EventType) (bool, error)
+	// Finish records the outcome of processing. A non-nil procErr marks the
+	// event failed so a redelivery may claim it again.
+	Finish(ctx context.Context, fingerprint string, procErr error) error
+	// Status returns the record for fingerprint or ErrEventNotFound.
+	Status(ctx context.Context, fingerprint string) (*EventRecord, error)
+}
+
+// Fingerprint identifies an event across redeliveries by its name and the
+// reference (or id) of its data, falling back to a hash of the data. Refunds
+// are identified by their own reference or id, never by the refunded
+// transaction, as one transaction can be refunded several times.
+func Fingerprint(event *Event) string {
+	var ids struct {
+		Reference            string      `json:"reference"`
+		TransferCode         string      `json:"transfer_code"`
+		SubscriptionCode     string      `json:"subscription_code"`
+		RefundReference      string      `json:"refund_reference"`
+		ID                   json.Number `json:"id"`
+		TransactionReference string      `json:"transaction_reference"`
+	}
+	json.Unmarshal(event.Raw, &ids)
+
+	candidates := []string{ids.Reference, ids.TransferCode, ids.SubscriptionCode, ids.RefundReference, ids.ID.String()}
+	if !strings.HasPrefix(string(event.Type), "refund.") {
+		candidates = append(candidates, ids.TransactionReference)
+	}
+	for _, id := range candidates {
+		if id != "" {
+			return fmt.Sprintf("%s:%s", event.Type, id)
+		}
+	}
+	sum := sha256.Sum256(event.Raw)
+	return fmt.Sprintf("%s:%s", event.Type, hex.EncodeToString(sum[:]))
+}
+
+// MemoryStore is an in-memory EventStore, suitable for tests and single instance
+// deployments. The zero value is ready to use.
+type MemoryStore struct {
+	// Lease, when positive, lets Begin reclaim events stuck in processing for
+	// longer than this, e.g. after a crash mid-handler.
+	Lease time.Duration
+
+	mu      sync.Mutex
+	records map[string]*EventRecord
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Begin implements EventStore.
+func (s *MemoryStore) Begin(ctx context.Context, fingerprint string, event EventType) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.records == nil {
+		s.records = make(map[string]*EventRecord)
+	}
+	record, ok := s.records[fingerprint]
+	if !ok {
+		s.records[fingerprint] = &EventRecord{
+			Fingerprint: fingerprint,
+			Event:       event,
+			Status:      StatusProcessing,
+			Attempts:    1,
+			FirstSeen:   now,
+			UpdatedAt:   now,
+		}
+		return true, nil
+	}
+
+	switch record.Status {
+	case StatusProcessed:
+		return false, nil
+	case StatusProcessing:
+		if s.Lease <= 0 || now.Sub(record.UpdatedAt) < s.Lease {
+			return false, nil
+		}
+	}
+	record.Status = StatusProcessing
+	record.Attempts++
+	record.UpdatedAt = now
+	return true, nil
+}
+
+// Finish implements EventStore.
+func (s *MemoryStore) Finish(ctx context.Context, fingerprint string, procErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[fingerprint]
+	if !ok {
+		return ErrEventNotFound
+	}
+	record.UpdatedAt = time.Now()
+	if procErr != nil {
+		record.Status = StatusFailed
+		record.LastError = procErr.Error()
+		return nil
+	}
+	record.Status = StatusProcessed
+	record.LastError = ""
+	return nil
+}
+
+// Status implements EventStore.
+func (s *MemoryStore) Status(ctx context.Context, fingerprint string) (*EventRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[fingerprint]
+	if !ok {
+		return nil, ErrEventNotFound
+	}
+	copied := *record
+	return &copied, nil
+}
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+func TestHandlerSkipsDuplicates(t *testing.T) {
+	store := NewMemoryStore()
+	h := NewHandler(paystack.NewPaystackClient(secret))
+	h.Store = store
+
+	credits, failures := 0, 1
+	h.OnChargeSuccess(func(ctx context.Context, data paystack.TransactionData) error {
+		if failures > 0 {
+			failures--
+			return errors.New("wallet service unavailable")
+		}
+		credits++
+		return nil
+	})
+
+	signature := Sign(secret, chargeSuccess)
+	// first delivery fails, the redelivery is processed, later ones are duplicates
+	want := []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK}
+	for i, status := range want {
+		if code := deliver(h, chargeSuccess, signature); code != status {
+			t.Errorf("Delivery %d: expected status %d, but got: %d", i+1, status, code)
+		}
+	}
+	if credits != 1 {
+		t.Errorf("Expected the wallet to be credited once, but got: %d", credits)
+	}
+
+	record, err := store.Status(context.Background(), "charge.success:qTPrJoy9Bx")
+	if err != nil {
+		t.Fatalf("Expected a record, but got: %v", err)
+	}
+	if record.Status != StatusProcessed || record.Attempts != 2 {
+		t.Errorf("Unexpected record: %+v", record)
+	}
+}
+
+func TestMemoryStoreUnknownFingerprint(t *testing.T) {
+	if _, err := NewMemoryStore().Status(context.Background(), "charge.success:nope"); !errors.Is(err, ErrEventNotFound) {
+		t.Errorf("Expected ErrEventNotFound, but got: %v", err)
+	}
+}
+
+func TestMemoryStoreLease(t *testing.T) {
+	ctx := context.Background()
+	store := &MemoryStore{Lease: 20 * time.Millisecond}
+
+	if ok, err := store.Begin(ctx, "charge.success:qTPrJoy9Bx", ChargeSuccess); !ok || err != nil {
+		t.Fatalf("Expected the first delivery to be claimed, but got: %v, %v", ok, err)
+	}
+	// a redelivery while the handler is still running is not claimed
+	if ok, _ := store.Begin(ctx, "charge.success:qTPrJoy9Bx", ChargeSuccess); ok {
+		t.Fatal("Expected the event to stay leased")
+	}
+
+	// once the lease expires, e.g. after a crash mid-handler, a redelivery takes over
+	time.Sleep(30 * time.Millisecond)
+	if ok, _ := store.Begin(ctx, "charge.success:qTPrJoy9Bx", ChargeSuccess); !ok {
+		t.Fatal("Expected the expired lease to be taken over")
+	}
+	record, err := store.Status(ctx, "charge.success:qTPrJoy9Bx")
+	if err != nil {
+		t.Fatalf("Expected a record, but got: %v", err)
+	}
+	if record.Status != StatusProcessing || record.Attempts != 2 {
+		t.Errorf("Unexpected record: %+v", record)
+	}
+}
+
+func TestFingerprintPartialRefunds(t *testing.T) {
+	refunds := []string{
+		`{"status":"processed","transaction_reference":"T685312322670591","refund_reference":"RF_1","amount":5000,"currency":"NGN"}`,
+		`{"status":"processed","transaction_reference":"T685312322670591","refund_reference":"RF_2","amount":5000,"currency":"NGN"}`,
+		`{"status":"processed","transaction_reference":"T685312322670591","refund_reference":null,"amount":5000,"currency":"NGN","id":41}`,
+		`{"status":"processed","transaction_reference":"T685312322670591","refund_reference":null,"amount":2500,"currency":"NGN"}`,
+	}
+	seen := make(map[string]bool)
+	for _, data := range refunds {
+		fingerprint := Fingerprint(&Event{Type: RefundProcessed, Raw: json.RawMessage(data)})
+		if seen[fingerprint] {
+			t.Errorf("Expected distinct refunds on one transaction to differ, but got %s twice", fingerprint)
+		}
+		seen[fingerprint] = true
+	}
+}
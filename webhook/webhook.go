@@ -0,0 +1,101 @@
+// Package webhook verifies and parses Paystack webhook deliveries.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SignatureHeader is the header carrying the HMAC-SHA512 signature of the body.
+const SignatureHeader = "x-paystack-signature"
+
+var (
+	// ErrMissingSignature is returned when a delivery carries no signature.
+	ErrMissingSignature = errors.New("webhook: missing " + SignatureHeader + " header")
+	// ErrInvalidSignature is returned when the signature does not match the body.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+)
+
+// UnknownEventError is returned by Parse for events this package has no type for.
+type UnknownEventError struct {
+	Event string
+}
+
+func (e *UnknownEventError) Error() string {
+	return fmt.Sprintf("webhook: unknown event %q", e.Event)
+}
+
+// Sign returns the hex encoded HMAC-SHA512 of body keyed with the secret key,
+// as sent by Paystack in the x-paystack-signature header.
+func Sign(secretKey string, body []byte) string {
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks signature against the raw body in constant time.
+func VerifySignature(secretKey string, body []byte, signature string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Event is a parsed webhook delivery.
+type Event struct {
+	Type EventType       // event name, e.g. "charge.success"
+	Raw  json.RawMessage // undecoded data object
+	// Data holds the decoded data object. Its type depends on Type, see EventType.
+	Data interface{}
+}
+
+// envelope is the JSON shape of every delivery.
+type envelope struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// Parse verifies the signature of body and decodes it into an Event.
+func Parse(secretKey string, body []byte, signature string) (*Event, error) {
+	if err := VerifySignature(secretKey, body, signature); err != nil {
+		return nil, err
+	}
+	return ParseEvent(body)
+}
+
+// ParseEvent decodes body into an Event without checking its signature.
+// Only use it for payloads whose origin was verified some other way.
+func ParseEvent(body []byte) (*Event, error) {
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, errors.New("webhook: error decoding JSON: " + err.Error())
+	}
+	if env.Event == "" {
+		return nil, errors.New("webhook: payload has no event name")
+	}
+
+	eventType := EventType(env.Event)
+	newData, ok := eventData[eventType]
+	if !ok {
+		return nil, &UnknownEventError{Event: env.Event}
+	}
+
+	data := newData()
+	if err := json.Unmarshal(env.Data, data); err != nil {
+		return nil, fmt.Errorf("webhook: error decoding %s data: %v", env.Event, err)
+	}
+	return &Event{Type: eventType, Raw: env.Data, Data: data}, nil
+}
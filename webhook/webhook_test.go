@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+
+	paystack "github.com/berryboylb/go_paystack_wrapper"
+)
+
+const secret = "sk_test_secret"
+
+var chargeSuccess = []byte(`{"event":"charge.success","data":{"id":302961,"domain":"live","status":"success","reference":"qTPrJoy9Bx","amount":10000,"metadata":{"order":"42"},"customer":{"id":68324,"email":"bojack@horsinaround.com","customer_code":"CUS_qo38as2hpsgk2r0"},"authorization":{"authorization_code":"AUTH_f5rnfq9p","reusable":true},"plan":{},"subaccount":{},"split":{}}}`)
+
+func TestParseChargeSuccess(t *testing.T) {
+	event, err := Parse(secret, chargeSuccess, Sign(secret, chargeSuccess))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if event.Type != ChargeSuccess {
+		t.Errorf("Expected event %q, but got: %q", ChargeSuccess, event.Type)
+	}
+	data, ok := event.Data.(*paystack.TransactionData)
+	if !ok {
+		t.Fatalf("Expected *paystack.TransactionData, but got: %T", event.Data)
+	}
+	if data.Reference != "qTPrJoy9Bx" || data.Customer.Email != "bojack@horsinaround.com" || data.Authorization.AuthorizationCode != "AUTH_f5rnfq9p" {
+		t.Errorf("Unexpected transaction data: %+v", data)
+	}
+}
+
+func TestParseTransferFailed(t *testing.T) {
+	body := []byte(`{"event":"transfer.failed","data":{"amount":30000,"currency":"NGN","reference":"ref_1","status":"failed","transfer_code":"TRF_1","recipient":{"recipient_code":"RCP_1","details":{"account_number":"0000000000","bank_code":"058"}}}}`)
+	event, err := Parse(secret, body, Sign(secret, body))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	data, ok := event.Data.(*Transfer)
+	if !ok || data.TransferCode != "TRF_1" || data.Recipient.RecipientCode != "RCP_1" {
+		t.Fatalf("Unexpected transfer data: %+v", event.Data)
+	}
+	if data.Amount != (paystack.Money{Amount: 30000, Currency: "NGN"}) || data.Status != paystack.TransferFailed {
+		t.Errorf("Unexpected transfer amount or status: %+v, %s", data.Amount, data.Status)
+	}
+}
+
+func TestParseSubscriptionCreate(t *testing.T) {
+	body := []byte(`{"event":"subscription.create","data":{"domain":"test","status":"active","subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","amount":50000,"cron_expression":"0 0 28 * *","next_payment_date":"2016-05-19T07:00:00.000Z","plan":{"name":"Monthly retainer","plan_code":"PLN_gx2wn530m0i3w3m","interval":"monthly","amount":50000,"currency":"NGN"},"authorization":{"authorization_code":"AUTH_96xphygz"},"customer":{"email":"bojack@horsinaround.com","customer_code":"CUS_xnxdt6s1zg1f4nx"}}}`)
+	event, err := Parse(secret, body, Sign(secret, body))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	data, ok := event.Data.(*paystack.Subscription)
+	if !ok || data.Status != paystack.SubscriptionActive || data.Plan.Interval != paystack.IntervalMonthly || data.NextPaymentDate == nil {
+		t.Fatalf("Unexpected subscription data: %+v", event.Data)
+	}
+	if data.Amount != paystack.NewMoney(50000, paystack.NGN) || data.Customer.CustomerCode != "CUS_xnxdt6s1zg1f4nx" {
+		t.Errorf("Unexpected subscription data: %+v", data)
+	}
+}
+
+func TestParseRefundProcessed(t *testing.T) {
+	body := []byte(`{"event":"refund.processed","data":{"status":"processed","transaction_reference":"T685312322670591","refund_reference":"RF_1","amount":5000,"currency":"NGN","processor":"mpgs_zen","customer":{"email":"bojack@horsinaround.com"},"integration":463433,"domain":"test"}}`)
+	event, err := Parse(secret, body, Sign(secret, body))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	data, ok := event.Data.(*Refund)
+	if !ok || data.Status != paystack.RefundProcessed || data.Amount != paystack.NewMoney(5000, paystack.NGN) {
+		t.Fatalf("Unexpected refund data: %+v", event.Data)
+	}
+	if data.TransactionReference != "T685312322670591" || *data.RefundReference != "RF_1" || data.Customer.Email != "bojack@horsinaround.com" {
+		t.Errorf("Unexpected refund details: %+v", data.RefundDetails)
+	}
+}
+
+func TestParseRejectsBadSignatures(t *testing.T) {
+	if _, err := Parse(secret, chargeSuccess, ""); !errors.Is(err, ErrMissingSignature) {
+		t.Errorf("Expected ErrMissingSignature, but got: %v", err)
+	}
+	if _, err := Parse(secret, chargeSuccess, Sign("sk_other", chargeSuccess)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, but got: %v", err)
+	}
+	if _, err := Parse(secret, chargeSuccess, "not-hex"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, but got: %v", err)
+	}
+}
+
+func TestParseUnknownEvent(t *testing.T) {
+	body := []byte(`{"event":"paymentrequest.pending","data":{}}`)
+	_, err := Parse(secret, body, Sign(secret, body))
+	var unknown *UnknownEventError
+	if !errors.As(err, &unknown) || unknown.Event != "paymentrequest.pending" {
+		t.Errorf("Expected *UnknownEventError, but got: %v", err)
+	}
+}